@@ -0,0 +1,102 @@
+package fecha
+
+import "fmt"
+
+// Ajuste es la convención que se utiliza para mover una fecha que cae en un
+// día no hábil (por ejemplo, el vencimiento de una cuota).
+type Ajuste int
+
+const (
+	// SinAjuste deja la fecha como está, aunque no sea hábil.
+	SinAjuste Ajuste = iota
+
+	// AjusteSiguiente mueve la fecha al próximo día hábil (Following).
+	AjusteSiguiente
+
+	// AjusteSiguienteModificado mueve la fecha al próximo día hábil, salvo que
+	// éste caiga en el mes siguiente; en ese caso la mueve al día hábil anterior
+	// (Modified Following).
+	AjusteSiguienteModificado
+
+	// AjusteAnterior mueve la fecha al día hábil anterior (Preceding).
+	AjusteAnterior
+
+	// AjusteAnteriorModificado mueve la fecha al día hábil anterior, salvo que
+	// éste caiga en el mes anterior; en ese caso la mueve al próximo día hábil
+	// (Modified Preceding).
+	AjusteAnteriorModificado
+)
+
+func (a Ajuste) String() string {
+	switch a {
+	case SinAjuste:
+		return "Unadjusted"
+	case AjusteSiguiente:
+		return "Following"
+	case AjusteSiguienteModificado:
+		return "Modified Following"
+	case AjusteAnterior:
+		return "Preceding"
+	case AjusteAnteriorModificado:
+		return "Modified Preceding"
+	}
+	return fmt.Sprintf("Ajuste(%v)", int(a))
+}
+
+// Ajustar devuelve la fecha movida a un día hábil según la convención de ajuste.
+// Si la fecha ya es hábil la devuelve sin cambios.
+// Si el calendario es nil se utiliza CalendarioPorDefecto.
+// Infinito y MenosInfinito se devuelven sin cambios.
+func (f Fecha) Ajustar(ajuste Ajuste, cal Calendario) Fecha {
+	if f.IsInfinite() {
+		return f
+	}
+	cal = calendarioOPorDefecto(cal)
+
+	switch ajuste {
+	case AjusteSiguiente:
+		return moverHastaHabil(f, cal, 1)
+
+	case AjusteSiguienteModificado:
+		siguiente := moverHastaHabil(f, cal, 1)
+		if siguiente.Mes() != f.Mes() {
+			return moverHastaHabil(f, cal, -1)
+		}
+		return siguiente
+
+	case AjusteAnterior:
+		return moverHastaHabil(f, cal, -1)
+
+	case AjusteAnteriorModificado:
+		anterior := moverHastaHabil(f, cal, -1)
+		if anterior.Mes() != f.Mes() {
+			return moverHastaHabil(f, cal, 1)
+		}
+		return anterior
+	}
+
+	return f
+}
+
+// EsFinDeMes devuelve true si la fecha es el último día del mes.
+// Infinito y MenosInfinito devuelven false.
+func (f Fecha) EsFinDeMes() bool {
+	if f.IsInfinite() {
+		return false
+	}
+	año, mes, dia := f.civil()
+	return dia == ultimoDia(mes, año)
+}
+
+// AgregarMesesFinDeMes es igual a AgregarMeses, pero si la fecha es el último
+// día del mes, el resultado también es el último día del mes destino.
+// Por ejemplo, sumar 1 mes al 28/02/2021 resulta en 31/03/2021
+// (AgregarMeses devolvería 28/03/2021).
+// Infinito y MenosInfinito se devuelven sin cambios.
+func (f Fecha) AgregarMesesFinDeMes(cantidad int) Fecha {
+	if !f.EsFinDeMes() {
+		return f.AgregarMeses(cantidad)
+	}
+	año, mes := normalizarMes(f.Año(), f.Mes()+cantidad)
+	return fechaDesdeCivil(año, mes, ultimoDia(mes, año))
+}
@@ -0,0 +1,58 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAjustar(t *testing.T) {
+	assert := assert.New(t)
+	cal := NewCalendarioFeriados(Feriado{Fecha(20200701), "Feriado"})
+
+	{ // Sábado 31/10/2020
+		f := Fecha(20201031)
+		assert.Equal(Fecha(20201031), f.Ajustar(SinAjuste, nil))
+		assert.Equal(Fecha(20201102), f.Ajustar(AjusteSiguiente, nil))
+		assert.Equal(Fecha(20201030), f.Ajustar(AjusteSiguienteModificado, nil))
+		assert.Equal(Fecha(20201030), f.Ajustar(AjusteAnterior, nil))
+		assert.Equal(Fecha(20201030), f.Ajustar(AjusteAnteriorModificado, nil))
+	}
+	{ // Sábado 01/08/2020
+		f := Fecha(20200801)
+		assert.Equal(Fecha(20200803), f.Ajustar(AjusteSiguiente, nil))
+		assert.Equal(Fecha(20200803), f.Ajustar(AjusteSiguienteModificado, nil))
+		assert.Equal(Fecha(20200731), f.Ajustar(AjusteAnterior, nil))
+		assert.Equal(Fecha(20200803), f.Ajustar(AjusteAnteriorModificado, nil))
+	}
+	{ // Feriado miércoles 01/07/2020
+		f := Fecha(20200701)
+		assert.Equal(Fecha(20200702), f.Ajustar(AjusteSiguiente, cal))
+		assert.Equal(Fecha(20200630), f.Ajustar(AjusteAnterior, cal))
+		assert.Equal(Fecha(20200702), f.Ajustar(AjusteAnteriorModificado, cal))
+		assert.Equal(f, f.Ajustar(AjusteSiguiente, nil))
+	}
+	{ // Día hábil
+		f := Fecha(20200702)
+		assert.Equal(f, f.Ajustar(AjusteSiguienteModificado, cal))
+		assert.Equal(f, f.Ajustar(AjusteAnteriorModificado, cal))
+	}
+}
+
+func TestAjusteString(t *testing.T) {
+	assert.Equal(t, "Modified Following", AjusteSiguienteModificado.String())
+	assert.Equal(t, "Ajuste(9)", Ajuste(9).String())
+}
+
+func TestAgregarMesesFinDeMes(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(Fecha(20210228).EsFinDeMes())
+	assert.False(Fecha(20200228).EsFinDeMes())
+
+	assert.Equal(Fecha(20210331), Fecha(20210228).AgregarMesesFinDeMes(1))
+	assert.Equal(Fecha(20210328), Fecha(20210228).AgregarMeses(1))
+	assert.Equal(Fecha(20210430), Fecha(20210331).AgregarMesesFinDeMes(1))
+	assert.Equal(Fecha(20200229), Fecha(20190930).AgregarMesesFinDeMes(5))
+	assert.Equal(Fecha(20201231), Fecha(20210228).AgregarMesesFinDeMes(-2))
+	assert.Equal(Fecha(20210315), Fecha(20210215).AgregarMesesFinDeMes(1))
+}
@@ -0,0 +1,215 @@
+package fecha
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// CalendarioArgentina considera no hábiles los sábados, domingos, los feriados
+// nacionales y los días no laborables con fines turísticos de la República Argentina.
+//
+// Los feriados se calculan por año según la Ley 27.399:
+//   - Inamovibles: se respetan en la fecha en que caen.
+//   - Trasladables: si caen martes o miércoles se trasladan al lunes anterior;
+//     si caen jueves o viernes, al lunes siguiente.
+//   - Carnaval y Viernes Santo: se calculan a partir de la fecha de Pascua.
+//   - Días no laborables con fines turísticos: los fija el Poder Ejecutivo por
+//     decreto, por lo que sólo se incluyen los de los años conocidos.
+//
+// Los feriados que se establezcan por decreto (traslados excepcionales,
+// feriados puente nuevos, etc.) pueden sumarse con Agregar.
+//
+// Es seguro utilizarlo desde varias goroutines.
+type CalendarioArgentina struct {
+	mu       sync.Mutex
+	años     map[int]map[Fecha]string
+	decretos map[Fecha]string
+}
+
+var _ CalendarioConFeriados = (*CalendarioArgentina)(nil)
+
+// NewCalendarioArgentina crea un calendario con los feriados nacionales argentinos.
+func NewCalendarioArgentina() *CalendarioArgentina {
+	return &CalendarioArgentina{
+		años:     map[int]map[Fecha]string{},
+		decretos: map[Fecha]string{},
+	}
+}
+
+// Agregar suma un feriado o día no laborable establecido por decreto.
+func (c *CalendarioArgentina) Agregar(f Fecha, nombre string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.decretos == nil {
+		c.decretos = map[Fecha]string{}
+	}
+	c.decretos[f] = nombre
+
+	// Si el año ya estaba calculado, lo actualizo
+	if feriados, ok := c.años[f.Año()]; ok {
+		agregarFeriado(feriados, f, nombre)
+	}
+}
+
+// EsHabil devuelve true si la fecha no es fin de semana ni feriado.
+func (c *CalendarioArgentina) EsHabil(f Fecha) bool {
+	if esFinDeSemana(f) {
+		return false
+	}
+	return !c.EsFeriado(f)
+}
+
+// EsFeriado devuelve true si la fecha es un feriado nacional o un día
+// no laborable con fines turísticos.
+func (c *CalendarioArgentina) EsFeriado(f Fecha) bool {
+	_, ok := c.Nombre(f)
+	return ok
+}
+
+// Nombre devuelve la descripción del feriado.
+// Si en la misma fecha coinciden dos feriados, se devuelven ambos nombres.
+// Si la fecha no es feriado devuelve false.
+func (c *CalendarioArgentina) Nombre(f Fecha) (nombre string, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nombre, ok = c.delAño(f.Año())[f]
+	return
+}
+
+// FeriadosEntre devuelve los feriados entre desde (inclusive) y hasta
+// (exclusive), sin ordenar.
+func (c *CalendarioArgentina) FeriadosEntre(desde, hasta Fecha) (out []Fecha) {
+	if hasta <= desde {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for año := desde.Año(); año <= hasta.Año(); año++ {
+		for k := range c.delAño(año) {
+			if k >= desde && k < hasta {
+				out = append(out, k)
+			}
+		}
+	}
+	return out
+}
+
+// Feriados devuelve los feriados del año ordenados por fecha.
+func (c *CalendarioArgentina) Feriados(año int) (out []Feriado) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, v := range c.delAño(año) {
+		out = append(out, Feriado{Fecha: k, Nombre: v})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Fecha < out[j].Fecha
+	})
+	return out
+}
+
+// Devuelve los feriados del año, calculándolos si es necesario.
+// Se supone que el mutex está tomado.
+func (c *CalendarioArgentina) delAño(año int) map[Fecha]string {
+	if c.años == nil {
+		c.años = map[int]map[Fecha]string{}
+	}
+	feriados, ok := c.años[año]
+	if ok {
+		return feriados
+	}
+
+	feriados = map[Fecha]string{}
+	for _, v := range FeriadosArgentina(año) {
+		agregarFeriado(feriados, v.Fecha, v.Nombre)
+	}
+	for k, v := range c.decretos {
+		if k.Año() == año {
+			agregarFeriado(feriados, k, v)
+		}
+	}
+	c.años[año] = feriados
+	return feriados
+}
+
+// FeriadosArgentina devuelve los feriados nacionales y días no laborables con
+// fines turísticos del año, ordenados por fecha.
+// Si dos feriados coinciden en la misma fecha, se devuelven ambos.
+func FeriadosArgentina(año int) (out []Feriado) {
+
+	inamovible := func(mes, dia int, nombre string) {
+		out = append(out, Feriado{NewFechaFromInts(año, mes, dia), nombre})
+	}
+	trasladable := func(mes, dia int, nombre string) {
+		out = append(out, Feriado{trasladarFeriado(NewFechaFromInts(año, mes, dia)), nombre})
+	}
+
+	inamovible(1, 1, "Año Nuevo")
+	out = append(out,
+		Feriado{LunesDeCarnaval(año), "Carnaval"},
+		Feriado{MartesDeCarnaval(año), "Carnaval"},
+	)
+	inamovible(3, 24, "Día Nacional de la Memoria por la Verdad y la Justicia")
+	inamovible(4, 2, "Día del Veterano y de los Caídos en la Guerra de Malvinas")
+	out = append(out, Feriado{ViernesSanto(año), "Viernes Santo"})
+	inamovible(5, 1, "Día del Trabajador")
+	inamovible(5, 25, "Día de la Revolución de Mayo")
+	trasladable(6, 17, "Paso a la Inmortalidad del General Martín Miguel de Güemes")
+	inamovible(6, 20, "Paso a la Inmortalidad del General Manuel Belgrano")
+	inamovible(7, 9, "Día de la Independencia")
+	trasladable(8, 17, "Paso a la Inmortalidad del General José de San Martín")
+	trasladable(10, 12, "Día del Respeto a la Diversidad Cultural")
+	trasladable(11, 20, "Día de la Soberanía Nacional")
+	inamovible(12, 8, "Inmaculada Concepción de María")
+	inamovible(12, 25, "Navidad")
+
+	for _, v := range diasTuristicosArgentina[año] {
+		out = append(out, Feriado{v, "Día no laborable con fines turísticos"})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Fecha < out[j].Fecha
+	})
+	return out
+}
+
+// Días no laborables con fines turísticos fijados por decreto (art. 7 Ley 27.399).
+var diasTuristicosArgentina = map[int][]Fecha{
+	2018: {20180430, 20181224, 20181231},
+	2019: {20190708, 20190819, 20191014},
+	2020: {20200323, 20200710, 20201207},
+	2021: {20210524, 20211008, 20211122},
+	2022: {20221007, 20221121, 20221209},
+	2023: {20230526, 20230619, 20231013},
+	2024: {20240401, 20240621, 20241011},
+	2025: {20250502, 20250815, 20251121},
+	2026: {20260323, 20260710, 20261207},
+}
+
+// Aplica la regla de traslado de la Ley 27.399: martes y miércoles pasan al
+// lunes anterior; jueves y viernes, al lunes siguiente.
+func trasladarFeriado(f Fecha) Fecha {
+	switch f.diaSemana() {
+	case time.Tuesday:
+		return f.AgregarDias(-1)
+	case time.Wednesday:
+		return f.AgregarDias(-2)
+	case time.Thursday:
+		return f.AgregarDias(4)
+	case time.Friday:
+		return f.AgregarDias(3)
+	}
+	return f
+}
+
+func agregarFeriado(feriados map[Fecha]string, f Fecha, nombre string) {
+	anterior, ok := feriados[f]
+	if ok && anterior != nombre {
+		nombre = anterior + " / " + nombre
+	}
+	feriados[f] = nombre
+}
@@ -0,0 +1,69 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeriadosArgentina(t *testing.T) {
+	assert := assert.New(t)
+
+	fechas := []Fecha{}
+	for _, v := range FeriadosArgentina(2024) {
+		fechas = append(fechas, v.Fecha)
+	}
+	assert.Equal([]Fecha{
+		20240101, // Año Nuevo
+		20240212, // Carnaval
+		20240213, // Carnaval
+		20240324, // Memoria
+		20240329, // Viernes Santo
+		20240401, // Turístico
+		20240402, // Malvinas
+		20240501, // Trabajador
+		20240525, // Revolución de Mayo
+		20240617, // Güemes (lunes)
+		20240620, // Belgrano
+		20240621, // Turístico
+		20240709, // Independencia
+		20240817, // San Martín (sábado, no se traslada)
+		20241011, // Turístico
+		20241012, // Diversidad Cultural (sábado, no se traslada)
+		20241118, // Soberanía Nacional (miércoles => lunes anterior)
+		20241208, // Inmaculada Concepción
+		20241225, // Navidad
+	}, fechas)
+}
+
+func TestTrasladarFeriado(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Fecha(20200615), trasladarFeriado(Fecha(20200617))) // Miércoles
+	assert.Equal(Fecha(20210621), trasladarFeriado(Fecha(20210617))) // Jueves
+	assert.Equal(Fecha(20250616), trasladarFeriado(Fecha(20250617))) // Martes
+	assert.Equal(Fecha(20231016), trasladarFeriado(Fecha(20231013))) // Viernes
+	assert.Equal(Fecha(20200817), trasladarFeriado(Fecha(20200817))) // Lunes
+	assert.Equal(Fecha(20241012), trasladarFeriado(Fecha(20241012))) // Sábado
+}
+
+func TestCalendarioArgentina(t *testing.T) {
+	assert := assert.New(t)
+	cal := NewCalendarioArgentina()
+
+	assert.True(cal.EsFeriado(Fecha(20240212)))
+	assert.False(cal.EsHabil(Fecha(20240212)))
+	assert.True(cal.EsHabil(Fecha(20240214)))
+
+	// Malvinas y Viernes Santo coinciden
+	nombre, ok := cal.Nombre(Fecha(20210402))
+	assert.True(ok)
+	assert.Equal("Día del Veterano y de los Caídos en la Guerra de Malvinas / Viernes Santo", nombre)
+
+	// Feriado por decreto
+	assert.True(cal.EsHabil(Fecha(20220518)))
+	cal.Agregar(Fecha(20220518), "Censo Nacional")
+	assert.False(cal.EsHabil(Fecha(20220518)))
+
+	// Viernes 14/08/2020 + 1 día hábil: el 17/08 es feriado
+	assert.Equal(Fecha(20200818), Fecha(20200814).AgregarDiasHabilesCalendario(1, cal))
+}
@@ -0,0 +1,383 @@
+package fecha
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgtype"
+)
+
+// Fechas es un slice de fechas que se persiste como DATE[]. Sirve para pasar
+// listas de fechas como parámetro, por ejemplo en "WHERE fecha = ANY($1)".
+//
+// Un slice nil se persiste como NULL y uno vacío como '{}'. Los elementos NULL
+// se leen como fecha cero y viceversa.
+type Fechas []Fecha
+
+// Meses es un slice de meses que se persiste como DATE[], guardando el primer
+// día de cada mes. Un slice nil se persiste como NULL y uno vacío como '{}'.
+// Los elementos NULL se leen como NilMes y viceversa.
+type Meses []Mes
+
+var _ pgtype.ValueTranscoder = (*Fechas)(nil)
+var _ pgtype.TypeValue = (*Fechas)(nil)
+var _ sql.Scanner = (*Fechas)(nil)
+var _ driver.Valuer = Fechas{}
+
+// DecodeBinary lee un DATE[] en formato binario. NULL se lee como nil.
+func (t *Fechas) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	a := pgtype.DateArray{}
+	err := a.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+	return t.Set(a)
+}
+
+// EncodeBinary guarda las fechas como DATE[]. Un slice nil se guarda como NULL.
+func (src Fechas) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	a, err := src.dateArray()
+	if err != nil {
+		return nil, err
+	}
+	return a.EncodeBinary(ci, buf)
+}
+
+// DecodeText lee un DATE[] en formato texto ("{2020-08-23,NULL}").
+// NULL se lee como nil.
+func (t *Fechas) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	a := pgtype.DateArray{}
+	err := a.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+	return t.Set(a)
+}
+
+// EncodeText guarda las fechas con el formato "{2020-08-23,2020-08-24}".
+// Un slice nil se guarda como NULL.
+func (src Fechas) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	a, err := src.dateArray()
+	if err != nil {
+		return nil, err
+	}
+	return a.EncodeText(ci, buf)
+}
+
+// TypeName returns the PostgreSQL name of this type.
+func (Fechas) TypeName() string {
+	return "_date"
+}
+
+func (t *Fechas) NewTypeValue() pgtype.Value {
+	return new(Fechas)
+}
+
+// Set acepta Fechas, []Fecha, []time.Time o un pgtype.DateArray.
+// nil se toma como NULL.
+func (t *Fechas) Set(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = nil
+	case Fechas:
+		*t = v
+	case []Fecha:
+		*t = v
+	case []time.Time:
+		if v == nil {
+			*t = nil
+			return nil
+		}
+		nuevo := make(Fechas, len(v))
+		for i, tm := range v {
+			nuevo[i] = NewFechaFromTime(tm)
+		}
+		*t = nuevo
+	case pgtype.DateArray:
+		nuevo, err := fechasDesdeDateArray(v)
+		if err != nil {
+			return err
+		}
+		*t = nuevo
+	default:
+		return fmt.Errorf("cannot convert %v to Fechas", src)
+	}
+	return nil
+}
+
+// Get devuelve las fechas, o nil si el slice es nil.
+func (t *Fechas) Get() interface{} {
+	if *t == nil {
+		return nil
+	}
+	return *t
+}
+
+// AssignTo asigna las fechas a *Fechas, *[]Fecha, *[]time.Time o
+// *pgtype.DateArray. Las fechas cero no se pueden asignar a *[]time.Time.
+func (t *Fechas) AssignTo(dst interface{}) error {
+	switch v := dst.(type) {
+	case *Fechas:
+		*v = *t
+	case *[]Fecha:
+		*v = *t
+	case *[]time.Time:
+		if *t == nil {
+			*v = nil
+			return nil
+		}
+		nuevo := make([]time.Time, len(*t))
+		for i, f := range *t {
+			if !f.IsValid() {
+				return fmt.Errorf("cannot assign invalid date '%v' to %T", int(f), dst)
+			}
+			nuevo[i] = f.Time()
+		}
+		*v = nuevo
+	case *pgtype.DateArray:
+		a, err := t.dateArray()
+		if err != nil {
+			return err
+		}
+		*v = a
+	default:
+		return fmt.Errorf("unable to assign to %T", dst)
+	}
+	return nil
+}
+
+// Value satisface la interface de package sql.
+// Lo persiste con el formato de texto de DATE[]: {2020-08-23,2020-08-24}
+func (src Fechas) Value() (driver.Value, error) {
+	return valueDateArray(src.dateArray())
+}
+
+// Scan satisface la interface de package sql.
+// Acepta el texto de un DATE[] o nil.
+func (t *Fechas) Scan(value interface{}) error {
+	a, err := scanDateArray(value)
+	if err != nil {
+		return err
+	}
+	return t.Set(a)
+}
+
+// Devuelve las fechas como pgtype.DateArray de una dimensión.
+func (src Fechas) dateArray() (pgtype.DateArray, error) {
+	if src == nil {
+		return pgtype.DateArray{Status: pgtype.Null}, nil
+	}
+	a := pgtype.DateArray{Status: pgtype.Present}
+	if len(src) == 0 {
+		return a, nil
+	}
+	a.Elements = make([]pgtype.Date, len(src))
+	for i, f := range src {
+		d, err := f.date()
+		if err != nil {
+			return pgtype.DateArray{}, err
+		}
+		a.Elements[i] = d
+	}
+	a.Dimensions = []pgtype.ArrayDimension{{Length: int32(len(src)), LowerBound: 1}}
+	return a, nil
+}
+
+// Convierte un pgtype.DateArray de una dimensión en Fechas. NULL se devuelve
+// como nil y los elementos NULL como fecha cero.
+func fechasDesdeDateArray(a pgtype.DateArray) (Fechas, error) {
+	switch a.Status {
+	case pgtype.Null, pgtype.Undefined:
+		return nil, nil
+	}
+	if len(a.Dimensions) > 1 {
+		return nil, fmt.Errorf("cannot decode array with %v dimensions", len(a.Dimensions))
+	}
+	out := make(Fechas, len(a.Elements))
+	for i, d := range a.Elements {
+		f, err := fechaDesdeDate(d)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = f
+	}
+	return out, nil
+}
+
+var _ pgtype.ValueTranscoder = (*Meses)(nil)
+var _ pgtype.TypeValue = (*Meses)(nil)
+var _ sql.Scanner = (*Meses)(nil)
+var _ driver.Valuer = Meses{}
+
+// DecodeBinary lee un DATE[] en formato binario. NULL se lee como nil.
+func (t *Meses) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	a := pgtype.DateArray{}
+	err := a.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+	return t.Set(a)
+}
+
+// EncodeBinary guarda el primer día de cada mes como DATE[].
+// Un slice nil se guarda como NULL.
+func (src Meses) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	a, err := src.dateArray()
+	if err != nil {
+		return nil, err
+	}
+	return a.EncodeBinary(ci, buf)
+}
+
+// DecodeText lee un DATE[] en formato texto ("{2020-08-01,NULL}").
+// NULL se lee como nil.
+func (t *Meses) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	a := pgtype.DateArray{}
+	err := a.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+	return t.Set(a)
+}
+
+// EncodeText guarda los meses con el formato "{2020-08-01,2020-09-01}".
+// Un slice nil se guarda como NULL.
+func (src Meses) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	a, err := src.dateArray()
+	if err != nil {
+		return nil, err
+	}
+	return a.EncodeText(ci, buf)
+}
+
+// TypeName returns the PostgreSQL name of this type.
+func (Meses) TypeName() string {
+	return "_date"
+}
+
+func (t *Meses) NewTypeValue() pgtype.Value {
+	return new(Meses)
+}
+
+// Set acepta Meses, []Mes o un pgtype.DateArray. nil se toma como NULL.
+func (t *Meses) Set(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = nil
+	case Meses:
+		*t = v
+	case []Mes:
+		*t = v
+	case pgtype.DateArray:
+		fechas, err := fechasDesdeDateArray(v)
+		if err != nil {
+			return err
+		}
+		meses, err := fechas.meses()
+		if err != nil {
+			return err
+		}
+		*t = meses
+	default:
+		return fmt.Errorf("cannot convert %v to Meses", src)
+	}
+	return nil
+}
+
+// Get devuelve los meses, o nil si el slice es nil.
+func (t *Meses) Get() interface{} {
+	if *t == nil {
+		return nil
+	}
+	return *t
+}
+
+// AssignTo asigna los meses a *Meses, *[]Mes o *pgtype.DateArray.
+func (t *Meses) AssignTo(dst interface{}) error {
+	switch v := dst.(type) {
+	case *Meses:
+		*v = *t
+	case *[]Mes:
+		*v = *t
+	case *pgtype.DateArray:
+		a, err := t.dateArray()
+		if err != nil {
+			return err
+		}
+		*v = a
+	default:
+		return fmt.Errorf("unable to assign to %T", dst)
+	}
+	return nil
+}
+
+// Value satisface la interface de package sql.
+// Lo persiste con el formato de texto de DATE[]: {2020-08-01,2020-09-01}
+func (src Meses) Value() (driver.Value, error) {
+	return valueDateArray(src.dateArray())
+}
+
+// Scan satisface la interface de package sql.
+// Acepta el texto de un DATE[] o nil.
+func (t *Meses) Scan(value interface{}) error {
+	a, err := scanDateArray(value)
+	if err != nil {
+		return err
+	}
+	return t.Set(a)
+}
+
+// Devuelve el primer día de cada mes como pgtype.DateArray de una dimensión.
+func (src Meses) dateArray() (pgtype.DateArray, error) {
+	if src == nil {
+		return pgtype.DateArray{Status: pgtype.Null}, nil
+	}
+	fechas := make(Fechas, len(src))
+	for i, m := range src {
+		if m.Zero() {
+			continue
+		}
+		if !m.Valid() && !m.IsInfinite() {
+			return pgtype.DateArray{}, fmt.Errorf("invalid month '%v-%v'", m.año, m.mes)
+		}
+		fechas[i] = m.PrimerDia()
+	}
+	return fechas.dateArray()
+}
+
+// Devuelve el mes de cada fecha. Las fechas cero se devuelven como NilMes,
+// Infinito y MenosInfinito como MesInfinito y MesMenosInfinito y las fechas
+// inválidas o fuera del rango de Mes devuelven error.
+func (src Fechas) meses() (Meses, error) {
+	if src == nil {
+		return nil, nil
+	}
+	out := make(Meses, len(src))
+	for i, f := range src {
+		m, err := mesDesdeFecha(f)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = m
+	}
+	return out, nil
+}
+
+func valueDateArray(a pgtype.DateArray, err error) (driver.Value, error) {
+	if err != nil {
+		return nil, err
+	}
+	return a.Value()
+}
+
+func scanDateArray(value interface{}) (a pgtype.DateArray, err error) {
+	switch v := value.(type) {
+	case nil, string, []byte:
+		err = a.Scan(v)
+	default:
+		err = fmt.Errorf("expected value type: string, got: %T", value)
+	}
+	return a, err
+}
@@ -0,0 +1,193 @@
+package fecha
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFechasPgx(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	for _, v := range []Fechas{
+		{20200823, 20200824},
+		{20200823, 0, 20200825},
+		{},
+	} {
+		by, err := v.EncodeBinary(ci, nil)
+		assert.Nil(err, "%v", v)
+		var f Fechas
+		assert.Nil(f.DecodeBinary(ci, by), "%v", v)
+		assert.Equal(v, f)
+
+		by, err = v.EncodeText(ci, nil)
+		assert.Nil(err, "%v", v)
+		f = nil
+		assert.Nil(f.DecodeText(ci, by), "%v", v)
+		assert.Equal(v, f)
+	}
+
+	by, err := Fechas{20200823, 0}.EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Equal("{2020-08-23,NULL}", string(by))
+
+	{ // NULL
+		by, err := Fechas(nil).EncodeBinary(ci, nil)
+		assert.Nil(err)
+		assert.Nil(by)
+
+		f := Fechas{20200823}
+		assert.Nil(f.DecodeBinary(ci, nil))
+		assert.Nil(f)
+	}
+
+	_, err = Fechas{20200231}.EncodeBinary(ci, nil)
+	assert.NotNil(err)
+
+	var f Fechas
+	assert.NotNil(f.DecodeText(ci, []byte("{{2020-08-23},{2020-08-24}}")))
+	assert.Nil(f.DecodeText(ci, []byte("{2020-08-23,infinity}")))
+	assert.Equal(Fechas{20200823, Infinito}, f)
+}
+
+func TestFechasSetYAssignTo(t *testing.T) {
+	assert := assert.New(t)
+	tm := time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC)
+
+	for _, v := range []interface{}{
+		Fechas{20200823},
+		[]Fecha{20200823},
+		[]time.Time{tm},
+	} {
+		var f Fechas
+		assert.Nil(f.Set(v), "%T", v)
+		assert.Equal(Fechas{20200823}, f, "%T", v)
+	}
+	f := Fechas{20200823}
+	assert.Nil(f.Set(nil))
+	assert.Nil(f)
+	assert.Nil(f.Get())
+	assert.NotNil(f.Set(3))
+
+	f = Fechas{20200823}
+	{
+		var dst []time.Time
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal([]time.Time{tm}, dst)
+	}
+	{
+		var dst []Fecha
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal([]Fecha{20200823}, dst)
+	}
+	{
+		var dst pgtype.DateArray
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal(pgtype.Present, dst.Status)
+		assert.Len(dst.Elements, 1)
+	}
+	assert.NotNil((&Fechas{0}).AssignTo(&[]time.Time{}))
+	assert.NotNil(f.AssignTo(new(string)))
+}
+
+func TestFechasSQL(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := Fechas{20200823, 0}.Value()
+	assert.Nil(err)
+	assert.Equal("{2020-08-23,NULL}", v)
+
+	v, err = Fechas{}.Value()
+	assert.Nil(err)
+	assert.Equal("{}", v)
+
+	v, err = Fechas(nil).Value()
+	assert.Nil(err)
+	assert.Nil(v)
+
+	var f Fechas
+	assert.Nil(f.Scan("{2020-08-23,NULL}"))
+	assert.Equal(Fechas{20200823, 0}, f)
+	assert.Nil(f.Scan([]byte("{}")))
+	assert.Equal(Fechas{}, f)
+	assert.Nil(f.Scan(nil))
+	assert.Nil(f)
+	assert.NotNil(f.Scan(3))
+	assert.NotNil(f.Scan("{23/08/2020}"))
+}
+
+func TestMesesPgx(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	m := Meses{{2020, 8}, NilMes, {2020, 9}}
+	by, err := m.EncodeBinary(ci, nil)
+	assert.Nil(err)
+	var leidos Meses
+	assert.Nil(leidos.DecodeBinary(ci, by))
+	assert.Equal(m, leidos)
+
+	by, err = m.EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Equal("{2020-08-01,NULL,2020-09-01}", string(by))
+	leidos = nil
+	assert.Nil(leidos.DecodeText(ci, by))
+	assert.Equal(m, leidos)
+
+	// Cualquier día del mes
+	assert.Nil(leidos.DecodeText(ci, []byte("{2020-08-23}")))
+	assert.Equal(Meses{{2020, 8}}, leidos)
+
+	assert.Nil(leidos.DecodeBinary(ci, nil))
+	assert.Nil(leidos)
+
+	_, err = Meses{{2020, 13}}.EncodeBinary(ci, nil)
+	assert.NotNil(err)
+
+	// Fechas fuera de rango
+	leidos = Meses{{2020, 8}}
+	assert.NotNil(leidos.DecodeText(ci, []byte("{0500-01-01}")))
+	by, err = pgtype.DateArray{
+		Elements:   []pgtype.Date{{Time: time.Date(500, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}},
+		Dimensions: []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}.EncodeBinary(ci, nil)
+	assert.Nil(err)
+	assert.NotNil(leidos.DecodeBinary(ci, by))
+	assert.Equal(Meses{{2020, 8}}, leidos)
+
+	// Infinitos
+	by, err = Meses{MesMenosInfinito(), MesInfinito()}.EncodeBinary(ci, nil)
+	assert.Nil(err)
+	assert.Nil(leidos.DecodeBinary(ci, by))
+	assert.Equal(Meses{MesMenosInfinito(), MesInfinito()}, leidos)
+
+	assert.Nil(leidos.Set([]Mes{{2020, 8}}))
+	assert.Equal(Meses{{2020, 8}}, leidos)
+	var dst []Mes
+	assert.Nil(leidos.AssignTo(&dst))
+	assert.Equal([]Mes{{2020, 8}}, dst)
+	assert.NotNil(leidos.AssignTo(new(string)))
+}
+
+func TestMesesSQL(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := Meses{{2020, 8}, {2020, 9}}.Value()
+	assert.Nil(err)
+	assert.Equal("{2020-08-01,2020-09-01}", v)
+
+	v, err = Meses(nil).Value()
+	assert.Nil(err)
+	assert.Nil(v)
+
+	var m Meses
+	assert.Nil(m.Scan("{2020-08-01,NULL}"))
+	assert.Equal(Meses{{2020, 8}, NilMes}, m)
+	assert.Nil(m.Scan(nil))
+	assert.Nil(m)
+	assert.NotNil(m.Scan(3.5))
+}
@@ -0,0 +1,213 @@
+package fecha
+
+import (
+	"fmt"
+	"math"
+)
+
+// BaseCalculo es la convención de conteo de días que se utiliza para
+// calcular la fracción de año entre dos fechas (por ejemplo, para devengar intereses).
+type BaseCalculo int
+
+const (
+	// Base30360US es la base 30/360 US (SIA). Si la fecha inicial es el último
+	// día de febrero se toma como 30, y si también lo es la final, ésta se toma como 30.
+	// El día 31 se toma como 30; en la fecha final sólo si la inicial es 30 o 31.
+	Base30360US BaseCalculo = iota + 1
+
+	// Base30E360 es la base 30E/360 europea (Eurobond). El día 31 se toma como 30
+	// en ambas fechas.
+	Base30E360
+
+	// Base30E360ISDA es la base 30E/360 ISDA. El último día de cada mes se toma
+	// como 30, salvo que la fecha final sea el vencimiento y caiga en febrero.
+	// Requiere el vencimiento en ParametrosBase.
+	Base30E360ISDA
+
+	// BaseACT360 divide los días reales por 360.
+	BaseACT360
+
+	// BaseACT365Fijo divide los días reales por 365.
+	BaseACT365Fijo
+
+	// BaseACTACTISDA divide los días reales de cada año calendario por 365 o 366,
+	// según sea bisiesto.
+	BaseACTACTISDA
+
+	// BaseACTACTICMA divide los días reales por la duración del período de cupón
+	// multiplicada por la frecuencia. Requiere el período de cupón en ParametrosBase.
+	BaseACTACTICMA
+)
+
+func (b BaseCalculo) String() string {
+	switch b {
+	case Base30360US:
+		return "30/360 US"
+	case Base30E360:
+		return "30E/360"
+	case Base30E360ISDA:
+		return "30E/360 ISDA"
+	case BaseACT360:
+		return "ACT/360"
+	case BaseACT365Fijo:
+		return "ACT/365 Fixed"
+	case BaseACTACTISDA:
+		return "ACT/ACT ISDA"
+	case BaseACTACTICMA:
+		return "ACT/ACT ICMA"
+	}
+	return fmt.Sprintf("BaseCalculo(%v)", int(b))
+}
+
+// ParametrosBase son los datos adicionales que necesitan algunas bases de cálculo.
+type ParametrosBase struct {
+	// Vencimiento del instrumento. Lo utiliza Base30E360ISDA.
+	Vencimiento Fecha
+
+	// Período de cupón que contiene las fechas y cantidad de cupones por año.
+	// Los utiliza BaseACTACTICMA.
+	InicioCupon Fecha
+	FinCupon    Fecha
+	Frecuencia  int
+}
+
+// DiasBase devuelve la cantidad de días entre las dos fechas según la base.
+// En las bases ACT son los días reales, igual que Diff.
+// Si hasta es anterior a desde, devuelve los días en negativo.
+// Con Infinito o MenosInfinito devuelve lo mismo que Diff.
+// Se supone que se está trabajando con fechas válidas.
+func DiasBase(desde, hasta Fecha, base BaseCalculo) int {
+	return DiasBaseConParametros(desde, hasta, base, ParametrosBase{})
+}
+
+// DiasBaseConParametros es igual a DiasBase, pero recibe los parámetros
+// adicionales que necesitan algunas bases.
+func DiasBaseConParametros(desde, hasta Fecha, base BaseCalculo, p ParametrosBase) int {
+	if n, ok := entreInfinitos(desde, hasta); ok {
+		return n
+	}
+	if hasta < desde {
+		return -DiasBaseConParametros(hasta, desde, base, p)
+	}
+
+	a1, m1, d1 := desde.civil()
+	a2, m2, d2 := hasta.civil()
+
+	switch base {
+	case Base30360US:
+		if m1 == 2 && d1 == ultimoDia(2, a1) {
+			if m2 == 2 && d2 == ultimoDia(2, a2) {
+				d2 = 30
+			}
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	case Base30E360:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 {
+			d2 = 30
+		}
+	case Base30E360ISDA:
+		if d1 == ultimoDia(m1, a1) {
+			d1 = 30
+		}
+		if d2 == ultimoDia(m2, a2) && !(hasta == p.Vencimiento && m2 == 2) {
+			d2 = 30
+		}
+	default:
+		return Diff(desde, hasta)
+	}
+	return 360*(a2-a1) + 30*(m2-m1) + d2 - d1
+}
+
+// FraccionAño devuelve la fracción de año entre las dos fechas según la base.
+// Si hasta es anterior a desde, devuelve la fracción en negativo.
+// Con Infinito o MenosInfinito devuelve +Inf, -Inf o 0, igual que Diff.
+//
+// Las bases que requieren parámetros adicionales (BaseACTACTICMA) devuelven
+// error; para ellas utilizar FraccionAñoConParametros. Base30E360ISDA sin
+// vencimiento considera que la fecha final no es el vencimiento.
+func FraccionAño(desde, hasta Fecha, base BaseCalculo) (float64, error) {
+	return FraccionAñoConParametros(desde, hasta, base, ParametrosBase{})
+}
+
+// FraccionAñoConParametros es igual a FraccionAño, pero recibe los parámetros
+// adicionales que necesitan algunas bases.
+//
+// En BaseACTACTICMA las fechas deben estar dentro del período de cupón; si el
+// devengamiento abarca varios períodos, se debe calcular cada uno por separado.
+func FraccionAñoConParametros(desde, hasta Fecha, base BaseCalculo, p ParametrosBase) (float64, error) {
+	if n, ok := entreInfinitos(desde, hasta); ok {
+		switch {
+		case n > 0:
+			return math.Inf(1), nil
+		case n < 0:
+			return math.Inf(-1), nil
+		}
+		return 0, nil
+	}
+	if !desde.IsValid() {
+		return 0, fmt.Errorf("invalid date desde '%v'", int(desde))
+	}
+	if !hasta.IsValid() {
+		return 0, fmt.Errorf("invalid date hasta '%v'", int(hasta))
+	}
+	if hasta < desde {
+		fraccion, err := FraccionAñoConParametros(hasta, desde, base, p)
+		return -fraccion, err
+	}
+
+	switch base {
+	case Base30360US, Base30E360, Base30E360ISDA:
+		return float64(DiasBaseConParametros(desde, hasta, base, p)) / 360, nil
+
+	case BaseACT360:
+		return float64(Diff(desde, hasta)) / 360, nil
+
+	case BaseACT365Fijo:
+		return float64(Diff(desde, hasta)) / 365, nil
+
+	case BaseACTACTISDA:
+		fraccion := 0.0
+		for año := desde.Año(); año <= hasta.Año(); año++ {
+			inicio := NewFechaFromInts(año, 1, 1)
+			if desde > inicio {
+				inicio = desde
+			}
+			fin := NewFechaFromInts(año+1, 1, 1)
+			if hasta < fin {
+				fin = hasta
+			}
+			diasDelAño := 365.0
+			if esBisiesto(año) {
+				diasDelAño = 366
+			}
+			fraccion += float64(Diff(inicio, fin)) / diasDelAño
+		}
+		return fraccion, nil
+
+	case BaseACTACTICMA:
+		if p.Frecuencia <= 0 {
+			return 0, fmt.Errorf("%v requires a positive coupon frequency", base)
+		}
+		if !p.InicioCupon.IsValid() || !p.FinCupon.IsValid() || p.FinCupon <= p.InicioCupon {
+			return 0, fmt.Errorf("%v requires a valid coupon period", base)
+		}
+		if desde < p.InicioCupon || hasta > p.FinCupon {
+			return 0, fmt.Errorf("%v: dates %v - %v are outside the coupon period %v - %v",
+				base, desde, hasta, p.InicioCupon, p.FinCupon)
+		}
+		dias := float64(Diff(desde, hasta))
+		diasCupon := float64(Diff(p.InicioCupon, p.FinCupon))
+		return dias / (float64(p.Frecuencia) * diasCupon), nil
+	}
+
+	return 0, fmt.Errorf("invalid base '%v'", base)
+}
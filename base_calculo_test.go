@@ -0,0 +1,127 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiasBase(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // 31 de enero al 31 de marzo
+		desde, hasta := Fecha(20200131), Fecha(20200331)
+		assert.Equal(60, DiasBase(desde, hasta, Base30360US))
+		assert.Equal(60, DiasBase(desde, hasta, Base30E360))
+		assert.Equal(60, DiasBase(desde, hasta, Base30E360ISDA))
+		assert.Equal(60, DiasBase(desde, hasta, BaseACT360))
+	}
+	{ // 30 de enero al 31 de marzo: sólo la base europea ajusta el 31
+		desde, hasta := Fecha(20200130), Fecha(20200331)
+		assert.Equal(60, DiasBase(desde, hasta, Base30360US))
+		assert.Equal(60, DiasBase(desde, hasta, Base30E360))
+	}
+	{ // 15 de enero al 31 de marzo
+		desde, hasta := Fecha(20200115), Fecha(20200331)
+		assert.Equal(76, DiasBase(desde, hasta, Base30360US))
+		assert.Equal(75, DiasBase(desde, hasta, Base30E360))
+		assert.Equal(75, DiasBase(desde, hasta, Base30E360ISDA))
+	}
+	{ // Último día de febrero
+		desde, hasta := Fecha(20200229), Fecha(20200331)
+		assert.Equal(30, DiasBase(desde, hasta, Base30360US))
+		assert.Equal(31, DiasBase(desde, hasta, Base30E360))
+		assert.Equal(30, DiasBase(desde, hasta, Base30E360ISDA))
+		assert.Equal(31, DiasBase(desde, hasta, BaseACT365Fijo))
+	}
+	{ // Febrero a febrero
+		desde, hasta := Fecha(20200229), Fecha(20210228)
+		assert.Equal(360, DiasBase(desde, hasta, Base30360US))
+		assert.Equal(359, DiasBase(desde, hasta, Base30E360))
+		assert.Equal(360, DiasBase(desde, hasta, Base30E360ISDA))
+	}
+	{ // 30E/360 ISDA con vencimiento en febrero
+		desde, hasta := Fecha(20200831), Fecha(20210228)
+		p := ParametrosBase{Vencimiento: hasta}
+		assert.Equal(180, DiasBase(desde, hasta, Base30E360ISDA))
+		assert.Equal(178, DiasBaseConParametros(desde, hasta, Base30E360ISDA, p))
+	}
+	{ // Invertido
+		assert.Equal(-60, DiasBase(Fecha(20200331), Fecha(20200131), Base30360US))
+	}
+}
+
+func TestFraccionAño(t *testing.T) {
+	assert := assert.New(t)
+
+	{
+		f, err := FraccionAño(Fecha(20200101), Fecha(20200701), BaseACT360)
+		assert.Nil(err)
+		assert.InDelta(182.0/360, f, 1e-12)
+	}
+	{
+		f, err := FraccionAño(Fecha(20200101), Fecha(20200701), BaseACT365Fijo)
+		assert.Nil(err)
+		assert.InDelta(182.0/365, f, 1e-12)
+	}
+	{
+		f, err := FraccionAño(Fecha(20200131), Fecha(20200331), Base30360US)
+		assert.Nil(err)
+		assert.InDelta(60.0/360, f, 1e-12)
+	}
+	{ // Cruza de un año común a uno bisiesto
+		f, err := FraccionAño(Fecha(20191215), Fecha(20200115), BaseACTACTISDA)
+		assert.Nil(err)
+		assert.InDelta(17.0/365+14.0/366, f, 1e-12)
+	}
+	{
+		f, err := FraccionAño(Fecha(20200101), Fecha(20210101), BaseACTACTISDA)
+		assert.Nil(err)
+		assert.InDelta(1, f, 1e-12)
+	}
+	{ // Invertido
+		f, err := FraccionAño(Fecha(20200701), Fecha(20200101), BaseACT360)
+		assert.Nil(err)
+		assert.InDelta(-182.0/360, f, 1e-12)
+	}
+	{ // ICMA sin parámetros
+		_, err := FraccionAño(Fecha(20200101), Fecha(20200401), BaseACTACTICMA)
+		assert.NotNil(err)
+	}
+	{ // Base inválida
+		_, err := FraccionAño(Fecha(20200101), Fecha(20200401), BaseCalculo(99))
+		assert.NotNil(err)
+	}
+	{ // Fecha inválida
+		_, err := FraccionAño(Fecha(0), Fecha(20200401), BaseACT360)
+		assert.NotNil(err)
+	}
+}
+
+func TestFraccionAñoICMA(t *testing.T) {
+	assert := assert.New(t)
+	p := ParametrosBase{
+		InicioCupon: Fecha(20200101),
+		FinCupon:    Fecha(20200701),
+		Frecuencia:  2,
+	}
+	{
+		f, err := FraccionAñoConParametros(Fecha(20200101), Fecha(20200701), BaseACTACTICMA, p)
+		assert.Nil(err)
+		assert.InDelta(0.5, f, 1e-12)
+	}
+	{
+		f, err := FraccionAñoConParametros(Fecha(20200101), Fecha(20200401), BaseACTACTICMA, p)
+		assert.Nil(err)
+		assert.InDelta(91.0/364, f, 1e-12)
+	}
+	{ // Fuera del período
+		_, err := FraccionAñoConParametros(Fecha(20200101), Fecha(20200801), BaseACTACTICMA, p)
+		assert.NotNil(err)
+	}
+}
+
+func TestBaseCalculoString(t *testing.T) {
+	assert.Equal(t, "ACT/ACT ICMA", BaseACTACTICMA.String())
+	assert.Equal(t, "BaseCalculo(0)", BaseCalculo(0).String())
+}
@@ -0,0 +1,365 @@
+package fecha
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Calendario determina qué días son hábiles.
+// Las funciones de días hábiles lo reciben como argumento para poder
+// considerar feriados propios de cada país, provincia o empresa.
+type Calendario interface {
+	// EsHabil devuelve true si la fecha es un día laborable.
+	EsHabil(Fecha) bool
+
+	// EsFeriado devuelve true si la fecha es un feriado o un día no laborable
+	// que no sea fin de semana.
+	EsFeriado(Fecha) bool
+}
+
+// CalendarioConFeriados es un Calendario que puede listar sus feriados.
+// DiasHabilesEntre lo utiliza para no tener que recorrer el rango día por día;
+// se supone que los días no hábiles del calendario son los sábados, los
+// domingos y los feriados que devuelve FeriadosEntre.
+type CalendarioConFeriados interface {
+	Calendario
+
+	// FeriadosEntre devuelve los feriados comprendidos entre desde (inclusive)
+	// y hasta (exclusive), incluso los que caen en fin de semana.
+	FeriadosEntre(desde, hasta Fecha) []Fecha
+}
+
+// SoloFinesDeSemana es el calendario por defecto: considera no hábiles
+// únicamente los sábados y domingos (no tiene en cuenta feriados).
+type SoloFinesDeSemana struct{}
+
+var _ Calendario = SoloFinesDeSemana{}
+
+// EsHabil devuelve true si la fecha no es sábado ni domingo.
+// Infinito y MenosInfinito no son hábiles.
+func (SoloFinesDeSemana) EsHabil(f Fecha) bool {
+	return !f.IsInfinite() && !esFinDeSemana(f)
+}
+
+// EsFeriado siempre devuelve false.
+func (SoloFinesDeSemana) EsFeriado(Fecha) bool {
+	return false
+}
+
+// CalendarioPorDefecto es el calendario que utilizan AgregarDiasHabiles y
+// las funciones que reciben un Calendario nil.
+var CalendarioPorDefecto Calendario = SoloFinesDeSemana{}
+
+// Feriado es un día no laborable con su descripción.
+type Feriado struct {
+	Fecha  Fecha  `json:"fecha"`
+	Nombre string `json:"nombre"`
+}
+
+// CalendarioFeriados considera no hábiles los sábados, domingos y
+// los feriados que se le hayan cargado.
+//
+// No es seguro agregar feriados mientras se lo consulta desde otras goroutines.
+type CalendarioFeriados struct {
+	feriados map[Fecha]string
+}
+
+var _ CalendarioConFeriados = (*CalendarioFeriados)(nil)
+
+// NewCalendarioFeriados crea un calendario con los feriados ingresados.
+func NewCalendarioFeriados(feriados ...Feriado) *CalendarioFeriados {
+	c := &CalendarioFeriados{
+		feriados: map[Fecha]string{},
+	}
+	for _, v := range feriados {
+		c.Agregar(v.Fecha, v.Nombre)
+	}
+	return c
+}
+
+// Agregar suma un feriado al calendario.
+// Si la fecha ya era feriado, se reemplaza el nombre.
+func (c *CalendarioFeriados) Agregar(f Fecha, nombre string) {
+	if c.feriados == nil {
+		c.feriados = map[Fecha]string{}
+	}
+	c.feriados[f] = nombre
+}
+
+// EsHabil devuelve true si la fecha no es fin de semana ni feriado.
+// Infinito y MenosInfinito no son hábiles.
+func (c *CalendarioFeriados) EsHabil(f Fecha) bool {
+	if f.IsInfinite() || esFinDeSemana(f) {
+		return false
+	}
+	return !c.EsFeriado(f)
+}
+
+// EsFeriado devuelve true si la fecha fue cargada como feriado.
+func (c *CalendarioFeriados) EsFeriado(f Fecha) bool {
+	_, ok := c.feriados[f]
+	return ok
+}
+
+// Nombre devuelve la descripción del feriado.
+// Si la fecha no es feriado devuelve false.
+func (c *CalendarioFeriados) Nombre(f Fecha) (nombre string, ok bool) {
+	nombre, ok = c.feriados[f]
+	return
+}
+
+// FeriadosEntre devuelve los feriados cargados entre desde (inclusive) y
+// hasta (exclusive), sin ordenar.
+func (c *CalendarioFeriados) FeriadosEntre(desde, hasta Fecha) (out []Fecha) {
+	for k := range c.feriados {
+		if k >= desde && k < hasta {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// Feriados devuelve todos los feriados cargados, ordenados por fecha.
+func (c *CalendarioFeriados) Feriados() (out []Feriado) {
+	for k, v := range c.feriados {
+		out = append(out, Feriado{Fecha: k, Nombre: v})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Fecha < out[j].Fecha
+	})
+	return out
+}
+
+// Combinar devuelve un calendario en el que un día es feriado si lo es en
+// alguno de los calendarios ingresados. Sirve para sumar a los feriados
+// nacionales los provinciales o los propios de la empresa.
+// Sin calendarios devuelve SoloFinesDeSemana.
+func Combinar(calendarios ...Calendario) Calendario {
+	if len(calendarios) == 0 {
+		return SoloFinesDeSemana{}
+	}
+	return calendarioCombinado(calendarios)
+}
+
+type calendarioCombinado []Calendario
+
+func (c calendarioCombinado) EsHabil(f Fecha) bool {
+	for _, v := range c {
+		if !v.EsHabil(f) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c calendarioCombinado) EsFeriado(f Fecha) bool {
+	for _, v := range c {
+		if v.EsFeriado(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// EsHabil devuelve true si la fecha es un día hábil según el calendario.
+// Si el calendario es nil se utiliza CalendarioPorDefecto.
+// Infinito y MenosInfinito no son hábiles.
+func (f Fecha) EsHabil(cal Calendario) bool {
+	if f.IsInfinite() {
+		return false
+	}
+	return calendarioOPorDefecto(cal).EsHabil(f)
+}
+
+// ProximoDiaHabil devuelve la misma fecha si es hábil. Si no lo es,
+// avanza hasta encontrar el próximo día hábil según el calendario.
+// Si el calendario es nil se utiliza CalendarioPorDefecto.
+// Infinito y MenosInfinito se devuelven sin cambios.
+func (f Fecha) ProximoDiaHabil(cal Calendario) (nuevaFecha Fecha) {
+	return moverHastaHabil(f, calendarioOPorDefecto(cal), 1)
+}
+
+// DiaHabilAnterior devuelve la misma fecha si es hábil. Si no lo es,
+// retrocede hasta encontrar el día hábil anterior según el calendario.
+// Si el calendario es nil se utiliza CalendarioPorDefecto.
+// Infinito y MenosInfinito se devuelven sin cambios.
+func (f Fecha) DiaHabilAnterior(cal Calendario) (nuevaFecha Fecha) {
+	return moverHastaHabil(f, calendarioOPorDefecto(cal), -1)
+}
+
+// AgregarDiasHabilesCalendario suma la cantidad de días hábiles especificados
+// según el calendario. Si la fecha no es hábil, primero se arrastra hasta el
+// próximo día hábil. Si la cantidad es negativa, resta días hábiles
+// (arrastrando primero hacia el día hábil anterior).
+// Si el calendario es nil se utiliza CalendarioPorDefecto.
+// Infinito y MenosInfinito se devuelven sin cambios.
+func (f Fecha) AgregarDiasHabilesCalendario(cantidad int, cal Calendario) (nuevaFecha Fecha) {
+	cal = calendarioOPorDefecto(cal)
+
+	paso := 1
+	if cantidad < 0 {
+		paso = -1
+		cantidad = -cantidad
+	}
+
+	nuevaFecha = moverHastaHabil(f, cal, paso)
+	for i := 0; i < cantidad; i++ {
+		nuevaFecha = moverHastaHabil(nuevaFecha.AgregarDias(paso), cal, paso)
+	}
+	return nuevaFecha
+}
+
+// DiasHabilesEntre devuelve la cantidad de días hábiles entre desde (inclusive)
+// y hasta (exclusive) según el calendario, de manera que si desde es hábil:
+//
+//	DiasHabilesEntre(desde, desde.AgregarDiasHabiles(n), nil) == n
+//
+// Si hasta es anterior a desde, devuelve la cantidad en negativo.
+// Si el calendario es nil se utiliza CalendarioPorDefecto.
+//
+// Los fines de semana se cuentan aritméticamente y los feriados se consultan
+// al calendario si implementa CalendarioConFeriados, por lo que no depende
+// de la cantidad de días del rango. Con otros calendarios recorre día por día.
+// Con Infinito o MenosInfinito devuelve lo mismo que Diff.
+// Se supone que se está trabajando con fechas válidas.
+func DiasHabilesEntre(desde, hasta Fecha, cal Calendario) int {
+	if n, ok := entreInfinitos(desde, hasta); ok {
+		return n
+	}
+	if hasta < desde {
+		return -DiasHabilesEntre(hasta, desde, cal)
+	}
+	cal = calendarioOPorDefecto(cal)
+
+	feriados, ok := feriadosEntre(cal, desde, hasta)
+	if !ok {
+		habiles := 0
+		for f := desde; f < hasta; f = f.AgregarDias(1) {
+			if cal.EsHabil(f) {
+				habiles++
+			}
+		}
+		return habiles
+	}
+
+	habiles := diasDeSemanaEntre(desde, hasta)
+	for _, v := range feriados {
+		if !esFinDeSemana(v) {
+			habiles--
+		}
+	}
+	return habiles
+}
+
+// Devuelve los feriados del calendario entre las fechas, sin repetidos.
+// Si el calendario no los puede listar devuelve false.
+func feriadosEntre(cal Calendario, desde, hasta Fecha) ([]Fecha, bool) {
+	switch c := cal.(type) {
+	case SoloFinesDeSemana:
+		return nil, true
+
+	case CalendarioConFeriados:
+		return c.FeriadosEntre(desde, hasta), true
+
+	case calendarioCombinado:
+		vistos := map[Fecha]struct{}{}
+		out := []Fecha{}
+		for _, v := range c {
+			feriados, ok := feriadosEntre(v, desde, hasta)
+			if !ok {
+				return nil, false
+			}
+			for _, f := range feriados {
+				if _, repetido := vistos[f]; !repetido {
+					vistos[f] = struct{}{}
+					out = append(out, f)
+				}
+			}
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+// Devuelve la cantidad de días de lunes a viernes entre desde (inclusive)
+// y hasta (exclusive).
+func diasDeSemanaEntre(desde, hasta Fecha) int {
+	dias := hasta.dias() - desde.dias()
+	habiles := dias / 7 * 5
+
+	dia := desde.diaSemana()
+	for i := 0; i < dias%7; i++ {
+		if dia != time.Saturday && dia != time.Sunday {
+			habiles++
+		}
+		dia = (dia + 1) % 7
+	}
+	return habiles
+}
+
+// maxDiasSinHabiles es la cantidad de días que se recorren buscando un día
+// hábil antes de considerar que el calendario no tiene ninguno.
+const maxDiasSinHabiles = 5 * 366
+
+// Avanza (paso 1) o retrocede (paso -1) hasta encontrar un día hábil.
+// Si no encuentra ninguno en maxDiasSinHabiles días hace panic, ya que el
+// calendario no tiene días hábiles. Los calendarios que se cargan de archivos
+// se validan con validarDiasHabiles, así que sólo puede pasar con una
+// implementación propia de Calendario.
+// Infinito y MenosInfinito se devuelven sin cambios.
+func moverHastaHabil(f Fecha, cal Calendario, paso int) Fecha {
+	if f.IsInfinite() {
+		return f
+	}
+	for i := 0; !cal.EsHabil(f); i++ {
+		if i >= maxDiasSinHabiles {
+			panic(fmt.Errorf("calendar has no business days within %v days of '%v'", maxDiasSinHabiles, f))
+		}
+		f = f.AgregarDias(paso)
+	}
+	return f
+}
+
+// Devuelve error si los feriados cubren maxDiasSinHabiles días seguidos o más
+// sin ningún día hábil, en cuyo caso moverHastaHabil no encontraría ninguno.
+func (c *CalendarioFeriados) validarDiasHabiles() error {
+	var inicio, fin Fecha
+	for _, v := range c.Feriados() {
+		f := v.Fecha
+		if !f.IsValid() || esFinDeSemana(f) {
+			continue
+		}
+		if fin == 0 || proximoDiaDeSemana(fin) != f {
+			inicio = f
+		}
+		fin = f
+		// Se suman los fines de semana que pueden rodear a los feriados
+		if Diff(inicio, fin)+4 >= maxDiasSinHabiles {
+			return fmt.Errorf("calendar has no business days between '%v' and '%v'", inicio, fin)
+		}
+	}
+	return nil
+}
+
+// Devuelve el próximo día de lunes a viernes, o cero si no hay una fecha válida.
+func proximoDiaDeSemana(f Fecha) Fecha {
+	for f = f.AgregarDias(1); f.IsValid(); f = f.AgregarDias(1) {
+		if !esFinDeSemana(f) {
+			return f
+		}
+	}
+	return 0
+}
+
+func calendarioOPorDefecto(cal Calendario) Calendario {
+	if cal == nil {
+		return CalendarioPorDefecto
+	}
+	return cal
+}
+
+func esFinDeSemana(f Fecha) bool {
+	dia := f.diaSemana()
+	return dia == time.Saturday || dia == time.Sunday
+}
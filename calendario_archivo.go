@@ -0,0 +1,321 @@
+package fecha
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// CargarCalendario crea un calendario con los feriados del archivo.
+// El formato se determina por la extensión: .json, .csv o .ics.
+func CargarCalendario(ruta string) (cal *CalendarioFeriados, err error) {
+	archivo, err := os.Open(ruta)
+	if err != nil {
+		return cal, err
+	}
+	defer archivo.Close()
+
+	switch strings.ToLower(filepath.Ext(ruta)) {
+	case ".json":
+		cal, err = NewCalendarioFromJSON(archivo)
+	case ".csv":
+		cal, err = NewCalendarioFromCSV(archivo)
+	case ".ics", ".ical":
+		cal, err = NewCalendarioFromICS(archivo)
+	default:
+		return cal, fmt.Errorf("unsupported calendar file '%v'", ruta)
+	}
+	if err != nil {
+		return cal, fmt.Errorf("reading '%v': %w", ruta, err)
+	}
+	return cal, nil
+}
+
+// NewCalendarioFromJSON crea un calendario a partir de una lista de feriados
+// con el formato:
+//
+//	[
+//		{"fecha": "2020-01-01", "nombre": "Año Nuevo"},
+//		{"fecha": "2020-02-24", "nombre": "Carnaval"}
+//	]
+func NewCalendarioFromJSON(r io.Reader) (cal *CalendarioFeriados, err error) {
+	feriados := []Feriado{}
+	err = json.NewDecoder(r).Decode(&feriados)
+	if err != nil {
+		return cal, fmt.Errorf("decoding JSON: %w", err)
+	}
+	for i, v := range feriados {
+		if !v.Fecha.IsValid() {
+			return cal, fmt.Errorf("holiday %v has no date", i)
+		}
+	}
+	cal = NewCalendarioFeriados(feriados...)
+	if err := cal.validarDiasHabiles(); err != nil {
+		return nil, err
+	}
+	return cal, nil
+}
+
+// NewCalendarioFromCSV crea un calendario a partir de un CSV con las columnas
+// fecha y nombre (opcional). La fecha puede estar en formato 2006-01-02 o 02/01/2006.
+// Si la primera fila es un encabezado ("fecha,nombre") se la ignora.
+// Las líneas que comienzan con # se consideran comentarios.
+func NewCalendarioFromCSV(r io.Reader) (cal *CalendarioFeriados, err error) {
+	lector := csv.NewReader(r)
+	lector.FieldsPerRecord = -1
+	lector.TrimLeadingSpace = true
+	lector.Comment = '#'
+
+	cal = NewCalendarioFeriados()
+	for i := 0; ; i++ {
+		fila, err := lector.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV: %w", err)
+		}
+		texto := strings.TrimSpace(fila[0])
+		if i == 0 && strings.EqualFold(texto, "fecha") {
+			continue
+		}
+
+		f, err := NewFecha(texto)
+		if err != nil {
+			f, err = NewFechaFromLayout("02/01/2006", texto)
+		}
+		if err != nil {
+			linea, _ := lector.FieldPos(0)
+			return nil, fmt.Errorf("line %v: invalid date '%v'", linea, texto)
+		}
+
+		nombre := ""
+		if len(fila) > 1 {
+			nombre = strings.TrimSpace(fila[1])
+		}
+		cal.Agregar(f, nombre)
+	}
+	if err := cal.validarDiasHabiles(); err != nil {
+		return nil, err
+	}
+	return cal, nil
+}
+
+// NewCalendarioFromICS crea un calendario a partir de un archivo iCalendar
+// (RFC 5545), como los que exportan Google Calendar o los sitios de gobierno.
+//
+// Se toman los VEVENT de día completo (DTSTART;VALUE=DATE). Si el evento
+// dura varios días, se consideran feriados todos los días hasta DTEND (exclusive).
+// Los eventos con hora se ignoran.
+//
+// De las reglas de repetición (RRULE) sólo se admite FREQ=YEARLY, con
+// INTERVAL, COUNT y UNTIL opcionales, y las excepciones de EXDATE. Si no
+// tiene COUNT ni UNTIL, el feriado se repite hasta AñoMaximo. Cualquier otra
+// regla devuelve error, para no perder feriados sin aviso.
+func NewCalendarioFromICS(r io.Reader) (cal *CalendarioFeriados, err error) {
+	lineas, err := leerLineasICS(r)
+	if err != nil {
+		return cal, fmt.Errorf("reading iCalendar: %w", err)
+	}
+
+	cal = NewCalendarioFeriados()
+	enEvento := false
+	var ev eventoICS
+	for _, linea := range lineas {
+		propiedad, valor, ok := strings.Cut(linea.texto, ":")
+		if !ok {
+			continue
+		}
+		nombreProp, parametros, _ := strings.Cut(propiedad, ";")
+		nombreProp = strings.ToUpper(nombreProp)
+
+		switch {
+		case nombreProp == "BEGIN" && strings.EqualFold(valor, "VEVENT"):
+			enEvento = true
+			ev = eventoICS{}
+
+		case nombreProp == "END" && strings.EqualFold(valor, "VEVENT"):
+			enEvento = false
+			if ev.desde == 0 {
+				continue
+			}
+			err = ev.agregarA(cal)
+			if err != nil {
+				return nil, fmt.Errorf("line %v: %w", ev.lineaRegla, err)
+			}
+
+		case !enEvento:
+			continue
+
+		case nombreProp == "DTSTART", nombreProp == "DTEND":
+			// Eventos con hora
+			if strings.Contains(valor, "T") && !strings.Contains(strings.ToUpper(parametros), "VALUE=DATE") {
+				ev.desde = 0
+				continue
+			}
+			f, err := NewFechaFromLayout("20060102", valor)
+			if err != nil {
+				return nil, fmt.Errorf("line %v: invalid date '%v'", linea.numero, valor)
+			}
+			if nombreProp == "DTSTART" {
+				ev.desde = f
+			} else {
+				ev.hasta = f
+			}
+
+		case nombreProp == "RRULE":
+			ev.regla = valor
+			ev.lineaRegla = linea.numero
+
+		case nombreProp == "EXDATE":
+			for _, v := range strings.Split(valor, ",") {
+				if len(v) > 8 {
+					v = v[:8]
+				}
+				f, err := NewFechaFromLayout("20060102", v)
+				if err != nil {
+					return nil, fmt.Errorf("line %v: invalid date '%v'", linea.numero, v)
+				}
+				ev.excepciones = append(ev.excepciones, f)
+			}
+
+		case nombreProp == "SUMMARY":
+			ev.nombre = desescaparICS(valor)
+		}
+	}
+	if err := cal.validarDiasHabiles(); err != nil {
+		return nil, err
+	}
+	return cal, nil
+}
+
+// Un VEVENT de día completo.
+type eventoICS struct {
+	desde, hasta Fecha
+	nombre       string
+
+	regla       string
+	lineaRegla  int
+	excepciones []Fecha
+}
+
+// Agrega al calendario los días del evento y de sus repeticiones.
+func (ev eventoICS) agregarA(cal *CalendarioFeriados) error {
+	dias := 1
+	if ev.hasta > ev.desde {
+		dias = Diff(ev.desde, ev.hasta)
+	}
+	inicios, err := ev.repeticiones()
+	if err != nil {
+		return err
+	}
+	for _, inicio := range inicios {
+		for i := 0; i < dias; i++ {
+			cal.Agregar(inicio.AgregarDias(i), ev.nombre)
+		}
+	}
+	return nil
+}
+
+// Devuelve el primer día de cada repetición del evento según RRULE.
+func (ev eventoICS) repeticiones() (out []Fecha, err error) {
+	if ev.regla == "" {
+		return []Fecha{ev.desde}, nil
+	}
+
+	intervalo, cantidad, hasta := 1, -1, NewFechaFromInts(AñoMaximo, 12, 31)
+	frecuencia := ""
+	for _, parte := range strings.Split(ev.regla, ";") {
+		clave, valor, _ := strings.Cut(parte, "=")
+		switch strings.ToUpper(clave) {
+		case "FREQ":
+			frecuencia = strings.ToUpper(valor)
+		case "INTERVAL":
+			intervalo, err = strconv.Atoi(valor)
+			if err != nil || intervalo < 1 {
+				return nil, fmt.Errorf("invalid RRULE interval '%v'", valor)
+			}
+		case "COUNT":
+			cantidad, err = strconv.Atoi(valor)
+			if err != nil || cantidad < 1 {
+				return nil, fmt.Errorf("invalid RRULE count '%v'", valor)
+			}
+		case "UNTIL":
+			if len(valor) > 8 {
+				valor = valor[:8]
+			}
+			hasta, err = NewFechaFromLayout("20060102", valor)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RRULE until '%v'", valor)
+			}
+		case "WKST":
+		default:
+			return nil, fmt.Errorf("unsupported RRULE '%v'", ev.regla)
+		}
+	}
+	if frecuencia != "YEARLY" {
+		return nil, fmt.Errorf("unsupported RRULE '%v' (only FREQ=YEARLY is supported)", ev.regla)
+	}
+
+	año, mes, dia := ev.desde.civil()
+	for n := 0; año+n <= AñoMaximo && cantidad != 0; n += intervalo {
+		f := NewFechaFromInts(año+n, mes, dia)
+		if f > hasta {
+			break
+		}
+		// El 29/02 sólo se repite en los años bisiestos
+		if f.Dia() != dia {
+			continue
+		}
+		cantidad--
+		if !contieneFecha(ev.excepciones, f) {
+			out = append(out, f)
+		}
+	}
+	return out, nil
+}
+
+func contieneFecha(fechas []Fecha, f Fecha) bool {
+	for _, v := range fechas {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
+
+// Línea de un archivo iCalendar con el número de la primera línea física
+// que la compone.
+type lineaICS struct {
+	texto  string
+	numero int
+}
+
+// Lee las líneas del archivo juntando las que están plegadas
+// (las que comienzan con espacio o tab continúan la anterior).
+func leerLineasICS(r io.Reader) (lineas []lineaICS, err error) {
+	scanner := bufio.NewScanner(r)
+	numero := 0
+	for scanner.Scan() {
+		numero++
+		linea := strings.TrimRight(scanner.Text(), "\r")
+		if len(linea) > 0 && (linea[0] == ' ' || linea[0] == '\t') && len(lineas) > 0 {
+			lineas[len(lineas)-1].texto += linea[1:]
+			continue
+		}
+		lineas = append(lineas, lineaICS{texto: linea, numero: numero})
+	}
+	return lineas, scanner.Err()
+}
+
+var desescaparTextoICS = strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func desescaparICS(texto string) string {
+	return strings.TrimSpace(desescaparTextoICS.Replace(texto))
+}
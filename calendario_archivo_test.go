@@ -0,0 +1,221 @@
+package fecha
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCalendarioFromJSON(t *testing.T) {
+	assert := assert.New(t)
+	{
+		cal, err := NewCalendarioFromJSON(strings.NewReader(`[
+			{"fecha": "2020-01-01", "nombre": "Año Nuevo"},
+			{"fecha": "2020-02-24", "nombre": "Carnaval"}
+		]`))
+		assert.Nil(err)
+		assert.Equal([]Feriado{
+			{20200101, "Año Nuevo"},
+			{20200224, "Carnaval"},
+		}, cal.Feriados())
+	}
+	{ // Sin fecha
+		_, err := NewCalendarioFromJSON(strings.NewReader(`[{"nombre": "Año Nuevo"}]`))
+		assert.NotNil(err)
+	}
+	{ // Fecha inválida
+		_, err := NewCalendarioFromJSON(strings.NewReader(`[{"fecha": "2020-02-30"}]`))
+		assert.NotNil(err)
+	}
+}
+
+func TestNewCalendarioFromCSV(t *testing.T) {
+	assert := assert.New(t)
+	{
+		cal, err := NewCalendarioFromCSV(strings.NewReader(
+			"fecha,nombre\n" +
+				"# Feriados provinciales\n" +
+				"2020-04-30, Fundación de la ciudad\n" +
+				"15/07/2020,\"Santo patrono, de la ciudad\"\n" +
+				"2020-11-02\n",
+		))
+		assert.Nil(err)
+		assert.Equal([]Feriado{
+			{20200430, "Fundación de la ciudad"},
+			{20200715, "Santo patrono, de la ciudad"},
+			{20201102, ""},
+		}, cal.Feriados())
+	}
+	{
+		_, err := NewCalendarioFromCSV(strings.NewReader("2020-13-01,Mal\n"))
+		assert.NotNil(err)
+	}
+}
+
+const icsPrueba = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20200224\r\n" +
+	"DTEND;VALUE=DATE:20200226\r\n" +
+	"SUMMARY:Carnaval\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20200817\r\n" +
+	"SUMMARY:Paso a la Inmortalidad del General José de San Martín\\, feri\r\n" +
+	" ado trasladable\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;TZID=America/Argentina/Buenos_Aires:20200820T100000\r\n" +
+	"DTEND;TZID=America/Argentina/Buenos_Aires:20200820T110000\r\n" +
+	"SUMMARY:Reunión\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestNewCalendarioFromICS(t *testing.T) {
+	assert := assert.New(t)
+	cal, err := NewCalendarioFromICS(strings.NewReader(icsPrueba))
+	assert.Nil(err)
+	assert.Equal([]Feriado{
+		{20200224, "Carnaval"},
+		{20200225, "Carnaval"},
+		{20200817, "Paso a la Inmortalidad del General José de San Martín, feriado trasladable"},
+	}, cal.Feriados())
+}
+
+func TestNewCalendarioFromICSRepeticiones(t *testing.T) {
+	assert := assert.New(t)
+	evento := func(propiedades ...string) string {
+		return "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n" +
+			strings.Join(propiedades, "\r\n") +
+			"\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	}
+	{ // Sin fin: se repite hasta AñoMaximo
+		cal, err := NewCalendarioFromICS(strings.NewReader(evento(
+			"DTSTART;VALUE=DATE:20200501",
+			"RRULE:FREQ=YEARLY",
+			"SUMMARY:Día del Trabajador",
+		)))
+		assert.Nil(err)
+		assert.True(cal.EsFeriado(20200501))
+		assert.True(cal.EsFeriado(20210501))
+		assert.True(cal.EsFeriado(20350501))
+		assert.False(cal.EsFeriado(20190501))
+		assert.Len(cal.Feriados(), AñoMaximo-2020+1)
+	}
+	{ // COUNT, INTERVAL, EXDATE y varios días
+		cal, err := NewCalendarioFromICS(strings.NewReader(evento(
+			"DTSTART;VALUE=DATE:20201224",
+			"DTEND;VALUE=DATE:20201226",
+			"RRULE:FREQ=YEARLY;INTERVAL=2;COUNT=3",
+			"EXDATE;VALUE=DATE:20221224",
+			"SUMMARY:Navidad",
+		)))
+		assert.Nil(err)
+		assert.Equal([]Feriado{
+			{20201224, "Navidad"},
+			{20201225, "Navidad"},
+			{20241224, "Navidad"},
+			{20241225, "Navidad"},
+		}, cal.Feriados())
+	}
+	{ // UNTIL y 29 de febrero
+		cal, err := NewCalendarioFromICS(strings.NewReader(evento(
+			"DTSTART;VALUE=DATE:20200229",
+			"RRULE:FREQ=YEARLY;UNTIL=20281231T000000Z",
+		)))
+		assert.Nil(err)
+		assert.Equal([]Feriado{{20200229, ""}, {20240229, ""}, {20280229, ""}}, cal.Feriados())
+	}
+	{ // Reglas no soportadas
+		_, err := NewCalendarioFromICS(strings.NewReader(evento(
+			"DTSTART;VALUE=DATE:20200817",
+			"SUMMARY:Reunión",
+			"RRULE:FREQ=WEEKLY",
+		)))
+		assert.NotNil(err)
+		assert.Contains(err.Error(), "line 5")
+
+		_, err = NewCalendarioFromICS(strings.NewReader(evento(
+			"DTSTART;VALUE=DATE:20200817",
+			"RRULE:FREQ=YEARLY;BYMONTH=8",
+		)))
+		assert.NotNil(err)
+	}
+}
+
+func TestNumeroDeLineaEnErrores(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewCalendarioFromCSV(strings.NewReader(
+		"fecha,nombre\n" +
+			"# Comentario\n" +
+			"# Otro comentario\n" +
+			"2020-04-30,Fundación\n" +
+			"2020-13-01,Mal\n",
+	))
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "line 5")
+
+	_, err = NewCalendarioFromICS(strings.NewReader(
+		"BEGIN:VCALENDAR\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"SUMMARY:Un nombre\r\n" +
+			" muy largo\r\n" +
+			"DTSTART;VALUE=DATE:20201301\r\n" +
+			"END:VEVENT\r\n" +
+			"END:VCALENDAR\r\n",
+	))
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "line 5")
+}
+
+func TestCalendarioArchivoSinHabiles(t *testing.T) {
+	assert := assert.New(t)
+
+	// Un archivo que marca como feriados todos los días de semana durante
+	// años se rechaza al cargarlo, en lugar de hacer panic al consultarlo.
+	var csv strings.Builder
+	it := NewIteradorFechas(20200101, 20251231)
+	for it.Siguiente() {
+		f := it.Fecha()
+		csv.WriteString(f.JSONString() + ",Feriado\n")
+	}
+	_, err := NewCalendarioFromCSV(strings.NewReader(csv.String()))
+	assert.NotNil(err)
+
+	// Un feriado largo pero con días hábiles alrededor es válido
+	csv.Reset()
+	it = NewIteradorFechas(20200101, 20200630)
+	for it.Siguiente() {
+		f := it.Fecha()
+		csv.WriteString(f.JSONString() + ",Feriado\n")
+	}
+	cal, err := NewCalendarioFromCSV(strings.NewReader(csv.String()))
+	assert.Nil(err)
+	assert.Equal(Fecha(20200701), Fecha(20200101).ProximoDiaHabil(cal))
+}
+
+func TestCargarCalendario(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	ruta := filepath.Join(dir, "feriados.ics")
+	assert.Nil(os.WriteFile(ruta, []byte(icsPrueba), 0644))
+	cal, err := CargarCalendario(ruta)
+	assert.Nil(err)
+	assert.True(cal.EsFeriado(20200225))
+
+	ruta = filepath.Join(dir, "feriados.csv")
+	assert.Nil(os.WriteFile(ruta, []byte("2020-04-30,Fundación\n"), 0644))
+	cal, err = CargarCalendario(ruta)
+	assert.Nil(err)
+	assert.True(cal.EsFeriado(20200430))
+
+	ruta = filepath.Join(dir, "feriados.txt")
+	assert.Nil(os.WriteFile(ruta, []byte("2020-04-30\n"), 0644))
+	_, err = CargarCalendario(ruta)
+	assert.NotNil(err)
+}
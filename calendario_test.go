@@ -0,0 +1,177 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoloFinesDeSemana(t *testing.T) {
+	assert := assert.New(t)
+	cal := SoloFinesDeSemana{}
+
+	assert.False(cal.EsHabil(Fecha(20170429))) // Sábado
+	assert.False(cal.EsHabil(Fecha(20170430))) // Domingo
+	assert.True(cal.EsHabil(Fecha(20170501)))  // Lunes
+	assert.False(cal.EsFeriado(Fecha(20170501)))
+}
+
+func TestCalendarioFeriados(t *testing.T) {
+	assert := assert.New(t)
+	cal := NewCalendarioFeriados(
+		Feriado{Fecha(20170501), "Día del Trabajador"},
+	)
+
+	assert.False(cal.EsHabil(Fecha(20170429)))
+	assert.False(cal.EsHabil(Fecha(20170501)))
+	assert.True(cal.EsFeriado(Fecha(20170501)))
+	assert.True(cal.EsHabil(Fecha(20170502)))
+
+	nombre, ok := cal.Nombre(Fecha(20170501))
+	assert.True(ok)
+	assert.Equal("Día del Trabajador", nombre)
+
+	cal.Agregar(Fecha(20170101), "Año Nuevo")
+	assert.Equal([]Feriado{
+		{Fecha(20170101), "Año Nuevo"},
+		{Fecha(20170501), "Día del Trabajador"},
+	}, cal.Feriados())
+}
+
+func TestAgregarDiasHabiles(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Viernes
+		f := Fecha(20200821)
+		assert.Equal(Fecha(20200821), f.AgregarDiasHabiles(0))
+		assert.Equal(Fecha(20200824), f.AgregarDiasHabiles(1))
+		assert.Equal(Fecha(20200828), f.AgregarDiasHabiles(5))
+		assert.Equal(Fecha(20200820), f.AgregarDiasHabiles(-1))
+		assert.Equal(Fecha(20200814), f.AgregarDiasHabiles(-5))
+	}
+	{ // Sábado: arrastra al lunes
+		f := Fecha(20200822)
+		assert.Equal(Fecha(20200824), f.AgregarDiasHabiles(0))
+		assert.Equal(Fecha(20200825), f.AgregarDiasHabiles(1))
+		assert.Equal(Fecha(20200820), f.AgregarDiasHabiles(-1))
+	}
+}
+
+func TestAgregarDiasHabilesCalendario(t *testing.T) {
+	assert := assert.New(t)
+	cal := NewCalendarioFeriados(
+		Feriado{Fecha(20200817), "Paso a la Inmortalidad del General José de San Martín"},
+	)
+
+	f := Fecha(20200814) // Viernes
+	assert.Equal(Fecha(20200818), f.AgregarDiasHabilesCalendario(1, cal))
+	assert.Equal(Fecha(20200814), Fecha(20200817).DiaHabilAnterior(cal))
+	assert.Equal(Fecha(20200818), Fecha(20200815).ProximoDiaHabil(cal))
+	assert.Equal(Fecha(20200814), Fecha(20200818).AgregarDiasHabilesCalendario(-1, cal))
+
+	// nil usa el calendario por defecto
+	assert.Equal(Fecha(20200817), f.AgregarDiasHabilesCalendario(1, nil))
+	assert.True(Fecha(20200817).EsHabil(nil))
+	assert.False(Fecha(20200817).EsHabil(cal))
+}
+
+func TestCombinar(t *testing.T) {
+	assert := assert.New(t)
+	nacional := NewCalendarioFeriados(Feriado{Fecha(20200817), "San Martín"})
+	provincial := NewCalendarioFeriados(Feriado{Fecha(20200818), "Provincial"})
+	cal := Combinar(nacional, provincial)
+
+	assert.False(cal.EsHabil(Fecha(20200817)))
+	assert.False(cal.EsHabil(Fecha(20200818)))
+	assert.True(cal.EsFeriado(Fecha(20200818)))
+	assert.False(cal.EsHabil(Fecha(20200822)))
+	assert.False(cal.EsFeriado(Fecha(20200822)))
+	assert.True(cal.EsHabil(Fecha(20200819)))
+}
+
+// Calendario que no implementa CalendarioConFeriados
+type calendarioSoloLunes struct{}
+
+func (calendarioSoloLunes) EsHabil(f Fecha) bool   { return f.diaSemana() == 1 }
+func (calendarioSoloLunes) EsFeriado(f Fecha) bool { return false }
+
+func TestCombinarSinCalendarios(t *testing.T) {
+	assert := assert.New(t)
+	cal := Combinar()
+	assert.True(cal.EsHabil(20200817))
+	assert.False(cal.EsHabil(20200822)) // Sábado
+	assert.False(cal.EsHabil(20200823)) // Domingo
+}
+
+type calendarioSinHabiles struct{}
+
+func (calendarioSinHabiles) EsHabil(f Fecha) bool   { return false }
+func (calendarioSinHabiles) EsFeriado(f Fecha) bool { return true }
+
+func TestCalendarioSinHabiles(t *testing.T) {
+	assert := assert.New(t)
+	assert.Panics(func() { Fecha(20200817).ProximoDiaHabil(calendarioSinHabiles{}) })
+	assert.Panics(func() { Fecha(20200817).AgregarDiasHabilesCalendario(-3, calendarioSinHabiles{}) })
+}
+
+func TestDiasHabilesEntre(t *testing.T) {
+	assert := assert.New(t)
+
+	// Del lunes 17/08/2020 al lunes 24/08/2020
+	assert.Equal(5, DiasHabilesEntre(Fecha(20200817), Fecha(20200824), nil))
+	assert.Equal(-5, DiasHabilesEntre(Fecha(20200824), Fecha(20200817), nil))
+	assert.Equal(0, DiasHabilesEntre(Fecha(20200817), Fecha(20200817), nil))
+	assert.Equal(0, DiasHabilesEntre(Fecha(20200822), Fecha(20200824), nil)) // Fin de semana
+	assert.Equal(1, DiasHabilesEntre(Fecha(20200821), Fecha(20200824), nil))
+
+	feriados := NewCalendarioFeriados(
+		Feriado{Fecha(20200817), "San Martín"},
+		Feriado{Fecha(20200822), "Sábado"},
+	)
+	assert.Equal(4, DiasHabilesEntre(Fecha(20200817), Fecha(20200824), feriados))
+	assert.Equal(5, DiasHabilesEntre(Fecha(20200818), Fecha(20200825), feriados))
+
+	assert.Equal(1, DiasHabilesEntre(Fecha(20200817), Fecha(20200824), calendarioSoloLunes{}))
+
+	// Inversa de AgregarDiasHabilesCalendario
+	desde := Fecha(20200803)
+	for _, n := range []int{0, 1, 7, 30, 250} {
+		hasta := desde.AgregarDiasHabilesCalendario(n, feriados)
+		assert.Equal(n, DiasHabilesEntre(desde, hasta, feriados))
+	}
+}
+
+func TestDiasHabilesEntreContraRecorrido(t *testing.T) {
+	assert := assert.New(t)
+
+	recorrer := func(desde, hasta Fecha, cal Calendario) (habiles int) {
+		for f := desde; f < hasta; f = f.AgregarDias(1) {
+			if cal.EsHabil(f) {
+				habiles++
+			}
+		}
+		return habiles
+	}
+
+	calendarios := []Calendario{
+		SoloFinesDeSemana{},
+		NewCalendarioArgentina(),
+		Combinar(NewCalendarioArgentina(), NewCalendarioFeriados(Feriado{Fecha(20210104), "Empresa"})),
+		Combinar(NewCalendarioArgentina(), calendarioSoloLunes{}),
+	}
+	desde := Fecha(20191228)
+	for _, cal := range calendarios {
+		for _, dias := range []int{0, 1, 2, 5, 6, 7, 8, 13, 100, 800} {
+			hasta := desde.AgregarDias(dias)
+			assert.Equal(recorrer(desde, hasta, cal), DiasHabilesEntre(desde, hasta, cal), "%T %v - %v", cal, desde, hasta)
+			desde = desde.AgregarDias(3)
+		}
+	}
+}
+
+func BenchmarkDiasHabilesEntre(b *testing.B) {
+	cal := NewCalendarioArgentina()
+	for i := 0; i < b.N; i++ {
+		DiasHabilesEntre(Fecha(20000101), Fecha(20301231), cal)
+	}
+}
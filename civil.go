@@ -0,0 +1,78 @@
+package fecha
+
+import "time"
+
+// Funciones de aritmética de calendario civil (gregoriano proléptico) que
+// trabajan sólo con enteros, sin pasar por time.Time ni por strings.
+// Los algoritmos son los de Howard Hinnant:
+// http://howardhinnant.github.io/date_algorithms.html
+
+// Devuelve la cantidad de días transcurridos desde el 01/01/1970.
+// El mes debe estar entre 1 y 12; el día puede estar fuera de rango,
+// en cuyo caso se desborda hacia los meses vecinos.
+func diasDesdeCivil(año, mes, dia int) int {
+	if mes <= 2 {
+		año--
+	}
+	era := divPiso(año, 400)
+	añoDeLaEra := año - era*400 // [0, 399]
+	mp := (mes + 9) % 12        // marzo = 0
+	diaDelAño := (153*mp+2)/5 + dia - 1
+	diaDeLaEra := añoDeLaEra*365 + añoDeLaEra/4 - añoDeLaEra/100 + diaDelAño
+	return era*146097 + diaDeLaEra - 719468
+}
+
+// Es la inversa de diasDesdeCivil.
+func civilDesdeDias(dias int) (año, mes, dia int) {
+	dias += 719468
+	era := divPiso(dias, 146097)
+	diaDeLaEra := dias - era*146097 // [0, 146096]
+	añoDeLaEra := (diaDeLaEra - diaDeLaEra/1460 + diaDeLaEra/36524 - diaDeLaEra/146096) / 365
+	diaDelAño := diaDeLaEra - (365*añoDeLaEra + añoDeLaEra/4 - añoDeLaEra/100)
+	mp := (5*diaDelAño + 2) / 153
+	dia = diaDelAño - (153*mp+2)/5 + 1
+	mes = mp + 3
+	if mes > 12 {
+		mes -= 12
+	}
+	año = añoDeLaEra + era*400
+	if mes <= 2 {
+		año++
+	}
+	return
+}
+
+// Devuelve el día de la semana del día número dias contado desde el 01/01/1970 (jueves).
+func diaDeLaSemanaDesdeDias(dias int) time.Weekday {
+	return time.Weekday(modPiso(dias+4, 7))
+}
+
+// Normaliza un mes fuera del rango 1-12, ajustando el año.
+func normalizarMes(año, mes int) (int, int) {
+	meses := año*12 + mes - 1
+	return divPiso(meses, 12), modPiso(meses, 12) + 1
+}
+
+func divPiso(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func modPiso(a, b int) int {
+	return a - divPiso(a, b)*b
+}
+
+func fechaDesdeCivil(año, mes, dia int) Fecha {
+	return Fecha(año*10000 + mes*100 + dia)
+}
+
+func fechaDesdeDias(dias int) Fecha {
+	return fechaDesdeCivil(civilDesdeDias(dias))
+}
+
+func esBisiesto(año int) bool {
+	return año%4 == 0 && año%100 != 0 || año%400 == 0
+}
@@ -0,0 +1,168 @@
+package fecha
+
+import "fmt"
+
+// Frecuencia indica cada cuánto vence una cuota. Se debe indicar
+// una cantidad de meses o una cantidad de días, no ambas.
+type Frecuencia struct {
+	Meses int
+	Dias  int
+}
+
+var (
+	FrecuenciaMensual    = Frecuencia{Meses: 1}
+	FrecuenciaBimestral  = Frecuencia{Meses: 2}
+	FrecuenciaTrimestral = Frecuencia{Meses: 3}
+	FrecuenciaSemestral  = Frecuencia{Meses: 6}
+	FrecuenciaAnual      = Frecuencia{Meses: 12}
+)
+
+// CadaNDias devuelve una frecuencia de n días.
+func CadaNDias(n int) Frecuencia {
+	return Frecuencia{Dias: n}
+}
+
+// Valid devuelve true si la frecuencia tiene meses o días, pero no ambos.
+func (fr Frecuencia) Valid() bool {
+	return fr.Meses > 0 && fr.Dias == 0 || fr.Meses == 0 && fr.Dias > 0
+}
+
+func (fr Frecuencia) String() string {
+	if fr.Dias > 0 {
+		return fmt.Sprintf("cada %v días", fr.Dias)
+	}
+	return fmt.Sprintf("cada %v meses", fr.Meses)
+}
+
+// Cronograma contiene los datos para generar los vencimientos de un crédito,
+// suscripción o cualquier producto en cuotas.
+//
+// Todos los vencimientos se calculan a partir de PrimerVencimiento (no
+// encadenando uno tras otro), por lo que el día del mes no se pierde:
+// 31/01 => 28/02 => 31/03 => 30/04.
+type Cronograma struct {
+	// PrimerVencimiento es la fecha de la primera cuota, antes de ajustarla.
+	PrimerVencimiento Fecha
+
+	// Cantidad de cuotas. Debe ser cero si se indica UltimoVencimiento.
+	Cantidad int
+
+	Frecuencia Frecuencia
+
+	// Ajuste que se aplica a los vencimientos que caen en días no hábiles.
+	Ajuste Ajuste
+
+	// Calendario con el que se ajustan los vencimientos.
+	// Si es nil se utiliza CalendarioPorDefecto.
+	Calendario Calendario
+
+	// FinDeMes indica que si PrimerVencimiento es el último día del mes, todos
+	// los vencimientos sean fin de mes (30/04 => 31/05 => 30/06).
+	FinDeMes bool
+
+	// Inicio es la fecha desde la que corre la primera cuota (opcional).
+	// Si no coincide con un período de la frecuencia antes de PrimerVencimiento,
+	// la primera cuota es irregular (más corta o más larga).
+	Inicio Fecha
+
+	// UltimoVencimiento es la fecha de la última cuota (opcional).
+	// Si no coincide con la frecuencia, la última cuota es irregular.
+	UltimoVencimiento Fecha
+}
+
+// Cuota es un período del cronograma.
+type Cuota struct {
+	// Numero de cuota, comenzando en 1.
+	Numero int
+
+	// Desde y Hasta son las fechas del período sin ajustar.
+	Desde Fecha
+	Hasta Fecha
+
+	// Vencimiento es Hasta ajustado según el calendario.
+	Vencimiento Fecha
+
+	// Irregular es true si el período no coincide con la frecuencia.
+	Irregular bool
+}
+
+// Cuotas genera los períodos del cronograma.
+func (c Cronograma) Cuotas() (cuotas []Cuota, err error) {
+	if !c.PrimerVencimiento.IsValid() {
+		return nil, fmt.Errorf("invalid first due date '%v'", int(c.PrimerVencimiento))
+	}
+	if !c.Frecuencia.Valid() {
+		return nil, fmt.Errorf("invalid frequency %+v", c.Frecuencia)
+	}
+	if c.Inicio != 0 && (!c.Inicio.IsValid() || c.Inicio >= c.PrimerVencimiento) {
+		return nil, fmt.Errorf("start date '%v' must be a valid date before the first due date", int(c.Inicio))
+	}
+	switch {
+	case c.UltimoVencimiento != 0 && c.Cantidad != 0:
+		return nil, fmt.Errorf("cannot set both Cantidad and UltimoVencimiento")
+	case c.UltimoVencimiento != 0 && (!c.UltimoVencimiento.IsValid() || c.UltimoVencimiento < c.PrimerVencimiento):
+		return nil, fmt.Errorf("last due date '%v' must be a valid date not before the first due date", int(c.UltimoVencimiento))
+	case c.UltimoVencimiento == 0 && c.Cantidad < 1:
+		return nil, fmt.Errorf("invalid number of installments %v", c.Cantidad)
+	}
+
+	// Fin de cada período sin ajustar
+	fines := []Fecha{}
+	if c.UltimoVencimiento != 0 {
+		for i := 0; ; i++ {
+			f := c.fechaN(i)
+			if f >= c.UltimoVencimiento {
+				fines = append(fines, c.UltimoVencimiento)
+				break
+			}
+			fines = append(fines, f)
+		}
+	} else {
+		for i := 0; i < c.Cantidad; i++ {
+			fines = append(fines, c.fechaN(i))
+		}
+	}
+
+	desde := c.fechaN(-1)
+	primeraIrregular := false
+	if c.Inicio != 0 && c.Inicio != desde {
+		desde = c.Inicio
+		primeraIrregular = true
+	}
+
+	for i, hasta := range fines {
+		cuota := Cuota{
+			Numero:      i + 1,
+			Desde:       desde,
+			Hasta:       hasta,
+			Vencimiento: hasta.Ajustar(c.Ajuste, c.Calendario),
+			Irregular:   i == 0 && primeraIrregular || hasta != c.fechaN(i),
+		}
+		cuotas = append(cuotas, cuota)
+		desde = hasta
+	}
+	return cuotas, nil
+}
+
+// Vencimientos devuelve sólo las fechas de vencimiento ajustadas.
+func (c Cronograma) Vencimientos() (fechas []Fecha, err error) {
+	cuotas, err := c.Cuotas()
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range cuotas {
+		fechas = append(fechas, v.Vencimiento)
+	}
+	return fechas, nil
+}
+
+// Devuelve el n-ésimo vencimiento sin ajustar, contado desde PrimerVencimiento (n = 0).
+func (c Cronograma) fechaN(n int) Fecha {
+	if c.Frecuencia.Dias > 0 {
+		return c.PrimerVencimiento.AgregarDias(n * c.Frecuencia.Dias)
+	}
+	if c.FinDeMes {
+		return c.PrimerVencimiento.AgregarMesesFinDeMes(n * c.Frecuencia.Meses)
+	}
+	return c.PrimerVencimiento.AgregarMeses(n * c.Frecuencia.Meses)
+}
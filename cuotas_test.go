@@ -0,0 +1,160 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronogramaMensual(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Cronograma{
+		PrimerVencimiento: Fecha(20210131),
+		Cantidad:          4,
+		Frecuencia:        FrecuenciaMensual,
+	}
+	v, err := c.Vencimientos()
+	assert.Nil(err)
+	assert.Equal([]Fecha{20210131, 20210228, 20210331, 20210430}, v)
+
+	// Con ajuste: 31/01/2021 es domingo y 31/03/2021 miércoles
+	c.Ajuste = AjusteSiguienteModificado
+	v, err = c.Vencimientos()
+	assert.Nil(err)
+	assert.Equal([]Fecha{20210129, 20210226, 20210331, 20210430}, v)
+
+	cuotas, err := c.Cuotas()
+	assert.Nil(err)
+	assert.Equal(Cuota{
+		Numero:      1,
+		Desde:       20201231,
+		Hasta:       20210131,
+		Vencimiento: 20210129,
+	}, cuotas[0])
+	assert.Equal(Cuota{
+		Numero:      2,
+		Desde:       20210131,
+		Hasta:       20210228,
+		Vencimiento: 20210226,
+	}, cuotas[1])
+}
+
+func TestCronogramaFinDeMes(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Cronograma{
+		PrimerVencimiento: Fecha(20210430),
+		Cantidad:          3,
+		Frecuencia:        FrecuenciaMensual,
+	}
+	v, err := c.Vencimientos()
+	assert.Nil(err)
+	assert.Equal([]Fecha{20210430, 20210530, 20210630}, v)
+
+	c.FinDeMes = true
+	v, err = c.Vencimientos()
+	assert.Nil(err)
+	assert.Equal([]Fecha{20210430, 20210531, 20210630}, v)
+}
+
+func TestCronogramaFrecuencias(t *testing.T) {
+	assert := assert.New(t)
+	{
+		v, err := Cronograma{PrimerVencimiento: 20201115, Cantidad: 3, Frecuencia: FrecuenciaTrimestral}.Vencimientos()
+		assert.Nil(err)
+		assert.Equal([]Fecha{20201115, 20210215, 20210515}, v)
+	}
+	{
+		v, err := Cronograma{PrimerVencimiento: 20201231, Cantidad: 3, Frecuencia: FrecuenciaBimestral}.Vencimientos()
+		assert.Nil(err)
+		assert.Equal([]Fecha{20201231, 20210228, 20210430}, v)
+	}
+	{
+		v, err := Cronograma{PrimerVencimiento: 20201230, Cantidad: 3, Frecuencia: CadaNDias(15)}.Vencimientos()
+		assert.Nil(err)
+		assert.Equal([]Fecha{20201230, 20210114, 20210129}, v)
+	}
+}
+
+func TestCronogramaIrregular(t *testing.T) {
+	assert := assert.New(t)
+
+	{ // Primera cuota corta
+		cuotas, err := Cronograma{
+			Inicio:            20210110,
+			PrimerVencimiento: 20210131,
+			Cantidad:          2,
+			Frecuencia:        FrecuenciaMensual,
+		}.Cuotas()
+		assert.Nil(err)
+		assert.Equal([]Cuota{
+			{1, 20210110, 20210131, 20210131, true},
+			{2, 20210131, 20210228, 20210228, false},
+		}, cuotas)
+	}
+	{ // Inicio regular
+		cuotas, err := Cronograma{
+			Inicio:            20201231,
+			PrimerVencimiento: 20210131,
+			Cantidad:          1,
+			Frecuencia:        FrecuenciaMensual,
+		}.Cuotas()
+		assert.Nil(err)
+		assert.False(cuotas[0].Irregular)
+	}
+	{ // Última cuota corta
+		cuotas, err := Cronograma{
+			PrimerVencimiento: 20210115,
+			UltimoVencimiento: 20210401,
+			Frecuencia:        FrecuenciaMensual,
+		}.Cuotas()
+		assert.Nil(err)
+		assert.Equal([]Cuota{
+			{1, 20201215, 20210115, 20210115, false},
+			{2, 20210115, 20210215, 20210215, false},
+			{3, 20210215, 20210315, 20210315, false},
+			{4, 20210315, 20210401, 20210401, true},
+		}, cuotas)
+	}
+	{ // Último vencimiento regular
+		cuotas, err := Cronograma{
+			PrimerVencimiento: 20210115,
+			UltimoVencimiento: 20210315,
+			Frecuencia:        FrecuenciaMensual,
+		}.Cuotas()
+		assert.Nil(err)
+		assert.Len(cuotas, 3)
+		assert.False(cuotas[2].Irregular)
+	}
+}
+
+func TestCronogramaInvalido(t *testing.T) {
+	assert := assert.New(t)
+	validos := Cronograma{PrimerVencimiento: 20210115, Cantidad: 3, Frecuencia: FrecuenciaMensual}
+
+	c := validos
+	c.PrimerVencimiento = 0
+	_, err := c.Cuotas()
+	assert.NotNil(err)
+
+	c = validos
+	c.Frecuencia = Frecuencia{Meses: 1, Dias: 1}
+	_, err = c.Cuotas()
+	assert.NotNil(err)
+
+	c = validos
+	c.Cantidad = 0
+	_, err = c.Cuotas()
+	assert.NotNil(err)
+
+	c = validos
+	c.UltimoVencimiento = 20210515
+	_, err = c.Cuotas()
+	assert.NotNil(err)
+
+	c = validos
+	c.Inicio = 20210116
+	_, err = c.Cuotas()
+	assert.NotNil(err)
+}
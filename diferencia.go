@@ -0,0 +1,119 @@
+package fecha
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MesesCompletos devuelve la cantidad de meses completos entre las dos fechas.
+//
+// Un mes se completa el mismo día del mes siguiente; si ese día no existe,
+// el último día del mes (igual que AgregarMeses y el art. 6 del Código Civil
+// y Comercial). Por ejemplo, del 31/01 al 28/02 hay un mes completo, y del
+// 29/02/2020 al 28/02/2021 hay doce.
+//
+// Si hasta es anterior a desde, devuelve los meses en negativo.
+// Con Infinito o MenosInfinito devuelve lo mismo que Diff.
+// Se supone que se está trabajando con fechas válidas.
+func MesesCompletos(desde, hasta Fecha) int {
+	if n, ok := entreInfinitos(desde, hasta); ok {
+		return n
+	}
+	if hasta < desde {
+		return -MesesCompletos(hasta, desde)
+	}
+	a1, m1, _ := desde.civil()
+	a2, m2, _ := hasta.civil()
+
+	meses := (a2-a1)*12 + m2 - m1
+	if desde.AgregarMeses(meses) > hasta {
+		meses--
+	}
+	return meses
+}
+
+// AñosCompletos devuelve la cantidad de años completos entre las dos fechas
+// (por ejemplo, la edad o la antigüedad). Sigue el mismo criterio que
+// MesesCompletos: quien nació el 29/02 cumple años el 28/02 de los años no bisiestos.
+//
+// Si hasta es anterior a desde, devuelve los años en negativo.
+// Con Infinito o MenosInfinito devuelve lo mismo que Diff.
+// Se supone que se está trabajando con fechas válidas.
+func AñosCompletos(desde, hasta Fecha) int {
+	if n, ok := entreInfinitos(desde, hasta); ok {
+		return n
+	}
+	return MesesCompletos(desde, hasta) / 12
+}
+
+// Diferencia es el tiempo transcurrido entre dos fechas expresado en años,
+// meses y días, por ejemplo "3 años, 2 meses y 5 días".
+// Si la diferencia es negativa, todos los valores son negativos (o cero).
+type Diferencia struct {
+	Años  int `json:"años"`
+	Meses int `json:"meses"`
+	Dias  int `json:"dias"`
+}
+
+// DiferenciaEntre devuelve los años y meses completos (según MesesCompletos)
+// y los días restantes entre las dos fechas, de manera que:
+//
+//	desde.AgregarMeses(d.Años*12 + d.Meses).AgregarDias(d.Dias) == hasta
+//
+// Si hasta es anterior a desde, devuelve la diferencia de hasta a desde en negativo.
+// Con Infinito o MenosInfinito, Años es lo que devuelve AñosCompletos y Meses
+// y Dias son cero.
+// Se supone que se está trabajando con fechas válidas.
+func DiferenciaEntre(desde, hasta Fecha) Diferencia {
+	if n, ok := entreInfinitos(desde, hasta); ok {
+		return Diferencia{Años: n}
+	}
+	if hasta < desde {
+		d := DiferenciaEntre(hasta, desde)
+		return Diferencia{Años: -d.Años, Meses: -d.Meses, Dias: -d.Dias}
+	}
+	meses := MesesCompletos(desde, hasta)
+	return Diferencia{
+		Años:  meses / 12,
+		Meses: meses % 12,
+		Dias:  Diff(desde.AgregarMeses(meses), hasta),
+	}
+}
+
+// IsZero devuelve true si no hay diferencia.
+func (d Diferencia) IsZero() bool {
+	return d == Diferencia{}
+}
+
+// String devuelve la diferencia en castellano, omitiendo los valores en cero:
+// "3 años, 2 meses y 5 días", "1 mes", "0 días".
+// Si es negativa se antepone un signo menos: "-1 año y 2 días".
+func (d Diferencia) String() string {
+	signo := ""
+	if d.Años < 0 || d.Meses < 0 || d.Dias < 0 {
+		signo = "-"
+		d = Diferencia{Años: -d.Años, Meses: -d.Meses, Dias: -d.Dias}
+	}
+
+	partes := []string{}
+	agregar := func(n int, singular, plural string) {
+		switch {
+		case n == 1:
+			partes = append(partes, fmt.Sprintf("%v %v", n, singular))
+		case n > 1:
+			partes = append(partes, fmt.Sprintf("%v %v", n, plural))
+		}
+	}
+	agregar(d.Años, "año", "años")
+	agregar(d.Meses, "mes", "meses")
+	agregar(d.Dias, "día", "días")
+
+	switch len(partes) {
+	case 0:
+		return "0 días"
+	case 1:
+		return signo + partes[0]
+	}
+	ultima := len(partes) - 1
+	return signo + strings.Join(partes[:ultima], ", ") + " y " + partes[ultima]
+}
@@ -0,0 +1,74 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMesesCompletos(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, MesesCompletos(Fecha(20200115), Fecha(20200214)))
+	assert.Equal(1, MesesCompletos(Fecha(20200115), Fecha(20200215)))
+	assert.Equal(12, MesesCompletos(Fecha(20200115), Fecha(20210115)))
+	assert.Equal(0, MesesCompletos(Fecha(20200115), Fecha(20200115)))
+	assert.Equal(-1, MesesCompletos(Fecha(20200215), Fecha(20200115)))
+
+	// Fin de mes
+	assert.Equal(1, MesesCompletos(Fecha(20210131), Fecha(20210228)))
+	assert.Equal(0, MesesCompletos(Fecha(20210131), Fecha(20210227)))
+	assert.Equal(1, MesesCompletos(Fecha(20210228), Fecha(20210328)))
+	assert.Equal(0, MesesCompletos(Fecha(20210330), Fecha(20210429)))
+	assert.Equal(1, MesesCompletos(Fecha(20210330), Fecha(20210430)))
+
+	// Contra AgregarMeses
+	desde := Fecha(20191129)
+	for i := 0; i < 400; i++ {
+		hasta := desde.AgregarDias(i * 3)
+		n := MesesCompletos(desde, hasta)
+		assert.True(desde.AgregarMeses(n) <= hasta)
+		assert.True(desde.AgregarMeses(n+1) > hasta)
+	}
+}
+
+func TestAñosCompletos(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(29, AñosCompletos(Fecha(19910823), Fecha(20200823)))
+	assert.Equal(28, AñosCompletos(Fecha(19910823), Fecha(20200822)))
+	assert.Equal(-28, AñosCompletos(Fecha(20200822), Fecha(19910823)))
+
+	// Nacido el 29 de febrero
+	assert.Equal(0, AñosCompletos(Fecha(20200229), Fecha(20210227)))
+	assert.Equal(1, AñosCompletos(Fecha(20200229), Fecha(20210228)))
+	assert.Equal(4, AñosCompletos(Fecha(20200229), Fecha(20240229)))
+}
+
+func TestDiferenciaEntre(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(Diferencia{3, 2, 5}, DiferenciaEntre(Fecha(20170310), Fecha(20200515)))
+	assert.Equal(Diferencia{-3, -2, -5}, DiferenciaEntre(Fecha(20200515), Fecha(20170310)))
+	assert.Equal(Diferencia{0, 1, 0}, DiferenciaEntre(Fecha(20210131), Fecha(20210228)))
+	assert.Equal(Diferencia{0, 1, 3}, DiferenciaEntre(Fecha(20210131), Fecha(20210303)))
+	assert.Equal(Diferencia{}, DiferenciaEntre(Fecha(20210131), Fecha(20210131)))
+
+	desde := Fecha(20191231)
+	for i := 0; i < 400; i++ {
+		hasta := desde.AgregarDias(i * 5)
+		d := DiferenciaEntre(desde, hasta)
+		assert.Equal(hasta, desde.AgregarMeses(d.Años*12+d.Meses).AgregarDias(d.Dias))
+	}
+}
+
+func TestDiferenciaString(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("3 años, 2 meses y 5 días", Diferencia{3, 2, 5}.String())
+	assert.Equal("1 año y 1 día", Diferencia{1, 0, 1}.String())
+	assert.Equal("1 mes", Diferencia{0, 1, 0}.String())
+	assert.Equal("0 días", Diferencia{}.String())
+	assert.Equal("-2 meses y 1 día", Diferencia{0, -2, -1}.String())
+	assert.True(Diferencia{}.IsZero())
+}
@@ -6,9 +6,10 @@ package fecha
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
 	"fmt"
 	"math"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -17,8 +18,43 @@ import (
 // Se entiende que todas las fechas están guardadas en UTC.
 type Fecha int
 
+// Infinito y MenosInfinito representan las fechas 'infinity' y '-infinity' de
+// PostgreSQL, que se usan por ejemplo como fin de un contrato sin vencimiento.
+// Son mayor y menor que cualquier otra fecha, por lo que se pueden comparar
+// como cualquier Fecha. En JSON y texto se escriben "infinity" y "-infinity".
+//
+// No son fechas válidas (IsValid devuelve false), pero se pueden usar como
+// límite en los cálculos:
+//   - AgregarDias, AgregarMeses y AgregarAños las devuelven sin cambios.
+//   - Las funciones que miden el tiempo entre dos fechas (Diff, MesesCompletos,
+//     AñosCompletos, DiferenciaEntre, DiasHabilesEntre, DiasBase y FraccionAño)
+//     devuelven el máximo si la otra fecha es anterior, el mínimo si es
+//     posterior y cero si son el mismo infinito.
+//   - Los días hábiles y ajustes (ProximoDiaHabil, DiaHabilAnterior,
+//     AgregarDiasHabilesCalendario, Ajustar y AgregarMesesFinDeMes) las
+//     devuelven sin cambios. No son hábiles ni fin de mes.
+//   - Semana devuelve NilSemana y PeriodoTrimestre, PeriodoSemestre y
+//     PeriodoAño devuelven NilTrimestre, NilSemestre y NilAño.
+//
+// No tienen día, mes ni año, así que Dia, Mes, Año y Time, igual que con
+// cualquier fecha inválida, no se deben llamar con ellas.
+//
+// Los valores extremos de int32 quedan reservados para los límites abiertos
+// de Rango, que en DATERANGE son distintos de 'infinity' y '-infinity'.
+const (
+	Infinito      Fecha = math.MaxInt32 - 1
+	MenosInfinito Fecha = math.MinInt32 + 1
+)
+
 // NewFecha parsea un texto con formato JSON.
+// Acepta también "infinity" y "-infinity".
 func NewFecha(texto string) (fch Fecha, err error) {
+	switch texto {
+	case "infinity":
+		return Infinito, nil
+	case "-infinity":
+		return MenosInfinito, nil
+	}
 
 	t, err := time.Parse("2006-01-02", texto)
 	if err != nil {
@@ -53,46 +89,90 @@ func NewFechaFromTime(t time.Time) (fch Fecha) {
 }
 
 // NewFechaFromInts le corta la hora y devuelve la fecha.
+// Si el mes o el día están fuera de rango se normalizan igual que en time.Date,
+// por ejemplo 31/04/2020 resulta en 01/05/2020.
 func NewFechaFromInts(año, mes, dia int) (fch Fecha) {
-	t := time.Date(año, time.Month(mes), dia, 0, 0, 0, 0, time.UTC)
-	fch = deTimeAFecha(t)
-	return
+	año, mes = normalizarMes(año, mes)
+	if dia >= 1 && dia <= ultimoDia(mes, año) {
+		return fechaDesdeCivil(año, mes, dia)
+	}
+	return fechaDesdeDias(diasDesdeCivil(año, mes, 1) + dia - 1)
 }
 
 // IsValid devuelve true si es una fecha válida.
+// Infinito y MenosInfinito no son fechas válidas.
 func (f Fecha) IsValid() bool {
-	_, err := time.Parse("20060102", fmt.Sprint(int(f)))
-	return err == nil
+	if f < 10000101 || f > 99991231 {
+		return false
+	}
+	año, mes, dia := f.partes()
+	return mes >= 1 && mes <= 12 && dia >= 1 && dia <= ultimoDia(mes, año)
 }
 
 // Time devuele la representación con el tipo time.Time
 func (f Fecha) Time() (nuevaFecha time.Time) {
-	enTexto := fmt.Sprint(int(f))
-	nuevaFecha, err := time.Parse("20060102", enTexto)
-	if err != nil {
-		panic(err)
-	}
-	return nuevaFecha
+	año, mes, dia := f.civil()
+	return time.Date(año, time.Month(mes), dia, 0, 0, 0, 0, time.UTC)
 }
 
 // Dia devuelve el número del día
 func (f Fecha) Dia() int {
-	return f.Time().Day()
+	_, _, dia := f.civil()
+	return dia
 }
 
 // Mes devuelve el número del mes.
 func (f Fecha) Mes() int {
-	return int(f.Time().Month())
+	_, mes, _ := f.civil()
+	return mes
 }
 
 // Año devuelve el año en formato 2006
 func (f Fecha) Año() int {
-	return f.Time().Year()
+	año, _, _ := f.civil()
+	return año
+}
+
+// Devuelve año, mes y día sin validar.
+func (f Fecha) partes() (año, mes, dia int) {
+	n := int(f)
+	return n / 10000, n / 100 % 100, n % 100
+}
+
+// Devuelve año, mes y día. Si la fecha no es válida hace panic.
+func (f Fecha) civil() (año, mes, dia int) {
+	if !f.IsValid() {
+		panic(fmt.Errorf("invalid date '%v'", int(f)))
+	}
+	return f.partes()
+}
+
+// Devuelve la cantidad de días transcurridos desde el 01/01/1970.
+// Si la fecha no es válida hace panic.
+func (f Fecha) dias() int {
+	return diasDesdeCivil(f.civil())
+}
+
+// Devuelve el día de la semana.
+func (f Fecha) diaSemana() time.Weekday {
+	return diaDeLaSemanaDesdeDias(f.dias())
+}
+
+// IsInfinite devuelve true si es Infinito o MenosInfinito.
+func (f Fecha) IsInfinite() bool {
+	return f == Infinito || f == MenosInfinito
 }
 
 // PeriodoMes devuelve la struct Mes correspondiente a la fecha.
 // Se supone que se está trabajando con una fecha válida.
+// Infinito y MenosInfinito devuelven MesInfinito y MesMenosInfinito.
 func (f Fecha) PeriodoMes() Mes {
+	switch f {
+	case Infinito:
+		return mesInfinito
+	case MenosInfinito:
+		return mesMenosInfinito
+	}
 	return Mes{
 		año: f.Año(),
 		mes: f.Mes(),
@@ -100,134 +180,179 @@ func (f Fecha) PeriodoMes() Mes {
 }
 
 // AgregarDias devuelve una nueva fecha con la cantidad de días agregados
-// Si el signo es negativo los resta.
+// Si el signo es negativo los resta. Infinito y MenosInfinito no cambian.
 func (f Fecha) AgregarDias(dias int) (NuevaFecha Fecha) {
-	enTime := f.Time().Add(time.Duration(24*dias) * time.Hour)
-	return deTimeAFecha(enTime)
+	if f.IsInfinite() {
+		return f
+	}
+	return fechaDesdeDias(f.dias() + dias)
 }
 
 // AgregarMeses suma la cantidad de meses deseados. El día siempre queda igual
 // salvo que el mes destino tenga menos días. Por ejemplo, sumar 1 mes al 31/01/2017
 // resulta en 28/02/2017
 func (f Fecha) AgregarMeses(cantidad int) (nuevaFecha Fecha) {
-	dia := f.Dia()
-	mes := f.Mes()
-	año := f.Año()
-
-	añosAgregar := 0
-
-	// Cambia año?
-	if mes+cantidad > 12 {
-		añosAgregar++
+	if f.IsInfinite() {
+		return f
 	}
+	año, mes, dia := f.civil()
 
-	añosAgregar = cantidad / 12
-
-	mesesAgregar := cantidad - añosAgregar*12
-	nuevoMes := mes + mesesAgregar
-	nuevoAño := año + añosAgregar
+	nuevoAño, nuevoMes := normalizarMes(año, mes+cantidad)
 
 	ultimoD := ultimoDia(nuevoMes, nuevoAño)
 	if ultimoD < dia {
 		dia = ultimoD
 	}
 
-	fec := time.Date(nuevoAño, time.Month(nuevoMes), dia, 0, 0, 0, 0, time.UTC)
-	return NewFechaFromTime(fec)
-
+	return fechaDesdeCivil(nuevoAño, nuevoMes, dia)
 }
 
-// AgregarAños devuelve una nueva fecha con los añós agregados
+// AgregarAños devuelve una nueva fecha con los añós agregados.
+// Si la fecha es 29/02 y el año destino no es bisiesto, resulta en 01/03.
 func (f Fecha) AgregarAños(cantidad int) (nuevaFecha Fecha) {
-	fechaT := f.Time()
-
-	dia := fechaT.Day()
-	mes := fechaT.Month()
-	año := fechaT.Year()
+	if f.IsInfinite() {
+		return f
+	}
+	año, mes, dia := f.civil()
 
 	nuevoAño := año + cantidad
 	if nuevoAño > 9999 {
 		return nuevaFecha
 	}
 
-	nuevaFecha = NewFechaFromTime(time.Date(nuevoAño, mes, dia, 0, 0, 0, 0, time.UTC))
-	return
+	return NewFechaFromInts(nuevoAño, mes, dia)
 }
 
 // Menos devuelve la cantidad de días de diferencia entre dos fechas
 // Se entiende que f2 es la fecha posterior.
 func (f Fecha) Menos(f2 Fecha) (dias int) {
-	horas := f.Time().Sub(f2.Time()).Hours()
-	dias = int(math.Trunc(horas / 24))
-	return dias
+	return Diff(f2, f)
 }
 
 // Diff calcula la diferencia de días entre dos fechas.Diff
 // Si la segunda fecha es anterior a la primera, devuelve los días en negativo.
+// Si alguna es Infinito o MenosInfinito devuelve math.MaxInt, math.MinInt o
+// 0 si son el mismo infinito.
 func Diff(f1, f2 Fecha) (dias int) {
+	if n, ok := entreInfinitos(f1, f2); ok {
+		return n
+	}
+	return f2.dias() - f1.dias()
+}
 
-	horas := f2.Time().Sub(f1.Time()).Hours()
-	dias = int(math.Trunc(horas / 24))
-	return dias
+// Si alguna de las fechas es Infinito o MenosInfinito devuelve math.MaxInt si
+// f1 es anterior a f2, math.MinInt si es posterior o 0 si son el mismo
+// infinito, y ok en true.
+func entreInfinitos(f1, f2 Fecha) (n int, ok bool) {
+	if !f1.IsInfinite() && !f2.IsInfinite() {
+		return 0, false
+	}
+	switch {
+	case f1 == f2:
+		return 0, true
+	case f1 < f2:
+		return math.MaxInt, true
+	}
+	return math.MinInt, true
 }
 
 // Agrupacion dice el intervalo que se desea para una TimeSeries
 type Agrupacion string
 
 const (
+	// AgrupacionDiaria devolverá todos los días
+	AgrupacionDiaria Agrupacion = "Diaria"
+	// AgrupacionSemanal devolverá las semanas agrupando el lunes como primer día
+	AgrupacionSemanal Agrupacion = "Semanal"
 	// AgrupacionMensual devolverá el primer día de cada mes
 	AgrupacionMensual Agrupacion = "Mensual"
-	// AgrupacionSemanal devolverá las semanas agrupando el lunes como primer día
-	AgrupacionSemanal = "Semanal"
+	// AgrupacionTrimestral devolverá el primer día de cada trimestre calendario
+	AgrupacionTrimestral Agrupacion = "Trimestral"
+	// AgrupacionSemestral devolverá el primer día de cada semestre calendario
+	AgrupacionSemestral Agrupacion = "Semestral"
+	// AgrupacionAnual devolverá el primer día de cada año
+	AgrupacionAnual Agrupacion = "Anual"
 )
 
-// TimeSeries devuelve todos los intervalos de fechas entre las dos fechas especificadas.
-// Por ejemplo: entre 01/05/2017 y 04/01/2018 = [01/05/2017, 01/06/2017, 01/08/2018, 01/09/2018...]
+// TimeSeries devuelve el primer día de cada intervalo que se superpone con
+// el rango comprendido entre las dos fechas especificadas (ambas inclusive).
+// Por ejemplo, mensual entre 07/05/2017 y 29/07/2018 = [01/05/2017, 01/06/2017, ..., 01/07/2018]
+//
+// Si desde y hasta caen en el mismo intervalo devuelve un solo elemento.
+// Si hasta es anterior a desde, o alguna de las fechas no es válida, devuelve error.
 func TimeSeries(desde, hasta Fecha, agrupacion Agrupacion) (fechas []Fecha, err error) {
+	return timeSeries(desde, hasta, agrupacion, time.Monday)
+}
+
+// TimeSeriesSemanal es igual a TimeSeries con AgrupacionSemanal, pero permite
+// indicar qué día de la semana inicia cada semana.
+func TimeSeriesSemanal(desde, hasta Fecha, inicioSemana time.Weekday) (fechas []Fecha, err error) {
+	return timeSeries(desde, hasta, AgrupacionSemanal, inicioSemana)
+}
+
+func timeSeries(desde, hasta Fecha, agrupacion Agrupacion, inicioSemana time.Weekday) (fechas []Fecha, err error) {
+	if !desde.IsValid() {
+		return fechas, fmt.Errorf("invalid date desde '%v'", int(desde))
+	}
+	if !hasta.IsValid() {
+		return fechas, fmt.Errorf("invalid date hasta '%v'", int(hasta))
+	}
+	if hasta < desde {
+		return fechas, fmt.Errorf("hasta '%v' is before desde '%v'", hasta, desde)
+	}
+
+	var siguiente func(Fecha) Fecha
+	inicio := desde
 	switch agrupacion {
+	case AgrupacionDiaria:
+		siguiente = func(f Fecha) Fecha { return f.AgregarDias(1) }
+	case AgrupacionSemanal:
+		retroceder := (int(desde.diaSemana()) - int(inicioSemana) + 7) % 7
+		inicio = desde.AgregarDias(-retroceder)
+		siguiente = func(f Fecha) Fecha { return f.AgregarDias(7) }
 	case AgrupacionMensual:
-		// Desde
-		mes := desde.Mes()
-		año := desde.Año()
-		f1Temp := time.Date(año, time.Month(mes), 1, 0, 0, 0, 0, time.UTC)
-		f1 := NewFechaFromTime(f1Temp)
-		// La primer fecha va seguro
-		fechas = append(fechas, f1)
-
-		// Hasta
-		mesHasta := hasta.Mes()
-		añoHasta := hasta.Año()
-		fnTemp := time.Date(añoHasta, time.Month(mesHasta), 1, 0, 0, 0, 0, time.UTC)
-		fn := NewFechaFromTime(fnTemp)
-
-		fSiguiente := f1
-		for {
-			fSiguiente = fSiguiente.AgregarMeses(1)
-			fechas = append(fechas, fSiguiente)
-
-			// Verifico si llegué a la última fecha
-			if fSiguiente == fn {
-				break
-			}
-		}
+		inicio = NewFechaFromInts(desde.Año(), desde.Mes(), 1)
+		siguiente = func(f Fecha) Fecha { return f.AgregarMeses(1) }
+	case AgrupacionTrimestral:
+		inicio = NewFechaFromInts(desde.Año(), (desde.Mes()-1)/3*3+1, 1)
+		siguiente = func(f Fecha) Fecha { return f.AgregarMeses(3) }
+	case AgrupacionSemestral:
+		inicio = NewFechaFromInts(desde.Año(), (desde.Mes()-1)/6*6+1, 1)
+		siguiente = func(f Fecha) Fecha { return f.AgregarMeses(6) }
+	case AgrupacionAnual:
+		inicio = NewFechaFromInts(desde.Año(), 1, 1)
+		siguiente = func(f Fecha) Fecha { return f.AgregarAños(1) }
 	default:
-		return fechas, fmt.Errorf("not implemented")
+		return fechas, fmt.Errorf("invalid agrupacion '%v'", agrupacion)
 	}
-	return
+
+	for f := inicio; f <= hasta; {
+		fechas = append(fechas, f)
+
+		// Si me pasé del año 9999 la fecha vuelve a cero
+		sig := siguiente(f)
+		if sig <= f {
+			break
+		}
+		f = sig
+	}
+	return fechas, nil
 }
 
 // MarshalJSON es para tomar un string y pasarlo a una fecha.Fecha
+// Infinito y MenosInfinito se marshalizan como "infinity" y "-infinity".
 func (f Fecha) MarshalJSON() (by []byte, err error) {
 	if f == 0 {
 		by = []byte("null")
 		return by, nil
 	}
-	if !f.IsValid() {
+	if !f.IsValid() && !f.IsInfinite() {
 		return by, fmt.Errorf("invalid date '%v'", int(f))
 	}
-	enTime := f.Time()
-	enString := enTime.Format("2006-01-02")
-	by = []byte(`"` + enString + `"`)
+	by = make([]byte, 0, 12)
+	by = append(by, '"')
+	by = f.appendISO(by)
+	by = append(by, '"')
 	return by, nil
 }
 
@@ -243,6 +368,15 @@ func (f *Fecha) UnmarshalJSON(input []byte) error {
 	// Quito las comillas
 	texto = strings.Replace(texto, `"`, "", -1)
 
+	switch texto {
+	case "infinity":
+		*f = Infinito
+		return nil
+	case "-infinity":
+		*f = MenosInfinito
+		return nil
+	}
+
 	// Si la fecha viene en formato Date de Javascript(), tomo la primera parte nomás
 	// 2020-02-04T03:00:00.000Z
 	if len(texto) == 24 {
@@ -258,19 +392,65 @@ func (f *Fecha) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
-// Transforma a Fecha un time
-func deTimeAFecha(f time.Time) (fecha Fecha) {
+var _ encoding.TextMarshaler = Fecha(0)
+var _ encoding.TextUnmarshaler = (*Fecha)(nil)
+var _ encoding.BinaryMarshaler = Fecha(0)
+var _ encoding.BinaryUnmarshaler = (*Fecha)(nil)
 
-	// Convierto la fecha a texto
-	enTexto := f.Format("20060102")
+// MarshalText devuelve la fecha con formato 2006-01-02.
+// Permite usar Fecha como clave de un map en JSON, en atributos XML o con flag.TextVar.
+// Si es cero devuelve un texto vacío.
+func (f Fecha) MarshalText() (by []byte, err error) {
+	if f == 0 {
+		return []byte{}, nil
+	}
+	if !f.IsValid() && !f.IsInfinite() {
+		return nil, fmt.Errorf("invalid date '%v'", int(f))
+	}
+	return f.appendISO(make([]byte, 0, 10)), nil
+}
 
-	// Convierto el texto al int
-	enInt, err := strconv.Atoi(enTexto)
+// UnmarshalText parsea una fecha con formato 2006-01-02.
+// Si el texto está vacío, la fecha queda en cero.
+func (f *Fecha) UnmarshalText(input []byte) error {
+	if len(input) == 0 {
+		*f = 0
+		return nil
+	}
+	nueva, err := NewFecha(string(input))
 	if err != nil {
-		panic(err)
+		return err
 	}
+	*f = nueva
+	return nil
+}
 
-	return Fecha(enInt)
+// MarshalBinary devuelve la fecha como un entero de 4 bytes big-endian
+// (20200823). La fecha cero se codifica como 0.
+func (f Fecha) MarshalBinary() ([]byte, error) {
+	if f != 0 && !f.IsValid() && !f.IsInfinite() {
+		return nil, fmt.Errorf("invalid date '%v'", int(f))
+	}
+	return binary.BigEndian.AppendUint32(make([]byte, 0, 4), uint32(f)), nil
+}
+
+// UnmarshalBinary lee una fecha codificada con MarshalBinary.
+func (f *Fecha) UnmarshalBinary(input []byte) error {
+	if len(input) != 4 {
+		return fmt.Errorf("invalid binary date: expected 4 bytes, got %v", len(input))
+	}
+	nueva := Fecha(int32(binary.BigEndian.Uint32(input)))
+	if nueva != 0 && !nueva.IsValid() && !nueva.IsInfinite() {
+		return fmt.Errorf("invalid date '%v'", int(nueva))
+	}
+	*f = nueva
+	return nil
+}
+
+// Transforma a Fecha un time
+func deTimeAFecha(f time.Time) (fecha Fecha) {
+	año, mes, dia := f.Date()
+	return fechaDesdeCivil(año, int(mes), dia)
 }
 
 // JSONString devuelve el la fecha en forato 2016-02-19
@@ -281,7 +461,8 @@ func (f *Fecha) JSONString() string {
 	if *f == 0 {
 		return "null"
 	}
-	return f.Time().Format("2006-01-02")
+	var buf [10]byte
+	return string(f.appendISO(buf[:0]))
 }
 
 func (f Fecha) String() string {
@@ -290,16 +471,52 @@ func (f Fecha) String() string {
 		return "01/01/0001"
 	}
 
-	enTexto := fmt.Sprint(int(f))
-	fecha, err := time.Parse("20060102", enTexto)
+	switch f {
+	case Infinito:
+		return "infinito"
+	case MenosInfinito:
+		return "-infinito"
+	}
 
 	// Si es inválida
-	if err != nil {
+	if !f.IsValid() {
 		return "N/A"
 	}
 
 	// Está ok
-	return fecha.Format("02/01/2006")
+	año, mes, dia := f.partes()
+	var buf [10]byte
+	by := appendDosDigitos(buf[:0], dia)
+	by = append(by, '/')
+	by = appendDosDigitos(by, mes)
+	by = append(by, '/')
+	by = appendCuatroDigitos(by, año)
+	return string(by)
+}
+
+// Agrega la fecha con formato 2006-01-02, o "infinity" y "-infinity".
+// Si la fecha no es válida hace panic.
+func (f Fecha) appendISO(by []byte) []byte {
+	switch f {
+	case Infinito:
+		return append(by, "infinity"...)
+	case MenosInfinito:
+		return append(by, "-infinity"...)
+	}
+	año, mes, dia := f.civil()
+	by = appendCuatroDigitos(by, año)
+	by = append(by, '-')
+	by = appendDosDigitos(by, mes)
+	by = append(by, '-')
+	return appendDosDigitos(by, dia)
+}
+
+func appendDosDigitos(by []byte, n int) []byte {
+	return append(by, byte('0'+n/10%10), byte('0'+n%10))
+}
+
+func appendCuatroDigitos(by []byte, n int) []byte {
+	return append(by, byte('0'+n/1000%10), byte('0'+n/100%10), byte('0'+n/10%10), byte('0'+n%10))
 }
 
 // IsZero devuelve true si la fecha es el número 0.
@@ -307,68 +524,28 @@ func (f Fecha) IsZero() bool {
 	return int(f) == 0
 }
 
-// DiaDeLaSemana devuelve la fecha del día para suegerirla en el index
+// DiaDeLaSemana devuelve la fecha del día para suegerirla en el index.
+// Es el nombre en IdiomaPorDefecto, con la primera letra en mayúscula.
 func (f Fecha) DiaDeLaSemana() string {
-	dia := f.Time().Weekday()
-	switch dia {
-	case 0:
-		return "Domingo"
-	case 1:
-		return "Lunes"
-	case 2:
-		return "Martes"
-	case 3:
-		return "Miércoles"
-	case 4:
-		return "Jueves"
-	case 5:
-		return "Viernes"
-	case 6:
-		return "Sábado"
-	}
-	return ""
+	return f.DiaDeLaSemanaIdioma(IdiomaPorDefecto)
 }
 
 // AgregarDiasHabiles suma la cantidad de días especificados en el argumento.
-// Considera los sábados y domingos (no tiene en cuenta feriados)
+// Utiliza CalendarioPorDefecto, que considera los sábados y domingos
+// (no tiene en cuenta feriados). Para considerar feriados utilizar
+// AgregarDiasHabilesCalendario.
 func (f Fecha) AgregarDiasHabiles(cantidad int) (nuevaFecha Fecha) {
-
-	// Si el primer día no es hábil, arrastro hasta el próximo día hábil
-	nuevaFecha = proximoDiaHabil(f)
-
-	for i := 0; i == cantidad; i++ {
-		// Agrego un día
-		nuevoTime := f.Time().Add(time.Duration(int64(i) * int64(time.Hour) * 24))
-		nuevaFecha = deTimeAFecha(nuevoTime)
-
-		// Este nuevo día, ¿Es hábil?
-		nuevaFecha = proximoDiaHabil(nuevaFecha)
-	}
-	return nuevaFecha
+	return f.AgregarDiasHabilesCalendario(cantidad, CalendarioPorDefecto)
 }
 
 // Si el día que se ingresa no es habil, avanza hacia adelante hasta encontrar uno.
 func proximoDiaHabil(f Fecha) (nuevaFecha Fecha) {
-	nuevaFecha = f
-	for {
-		if diaHabil(nuevaFecha) == true {
-			break
-		}
-		if diaHabil(nuevaFecha) == false {
-			// Agrego un día hasta llegar a un día habil
-			nuevaFecha = deTimeAFecha(nuevaFecha.Time().Add(time.Hour * 24))
-		}
-	}
-	return nuevaFecha
+	return f.ProximoDiaHabil(CalendarioPorDefecto)
 }
 
 // Si es un día hábil devuelve true
 func diaHabil(f Fecha) bool {
-	fecha := f.Time().UTC()
-	if fecha.Weekday() == time.Saturday || fecha.Weekday() == time.Sunday {
-		return false
-	}
-	return true
+	return CalendarioPorDefecto.EsHabil(f)
 }
 
 var _ driver.Valuer = (*Fecha)(nil)
@@ -379,7 +556,7 @@ func (f Fecha) Value() (driver.Value, error) {
 	if f.IsZero() {
 		return nil, nil
 	}
-	if !f.IsValid() {
+	if !f.IsValid() && !f.IsInfinite() {
 		return nil, fmt.Errorf("invalid date %v", int(f))
 	}
 	return f.JSONString(), nil
@@ -415,5 +592,13 @@ func (f *Fecha) Scan(value interface{}) error {
 		return nil
 	}
 
+	// Los drivers devuelven 'infinity' y '-infinity' como texto
+	switch v := value.(type) {
+	case string:
+		return f.UnmarshalText([]byte(v))
+	case []byte:
+		return f.UnmarshalText(v)
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package fecha
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgtype"
 )
@@ -10,107 +11,201 @@ var _ pgtype.ValueTranscoder = (*Fecha)(nil)
 var _ pgtype.Value = (*Fecha)(nil)
 var _ pgtype.TypeValue = (*Fecha)(nil)
 
+// DecodeBinary lee un DATE en formato binario. NULL se lee como fecha cero
+// e 'infinity' y '-infinity' como Infinito y MenosInfinito.
 func (t *Fecha) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
-	r := &pgtype.Date{}
-
-	err := r.DecodeBinary(ci, src)
+	f, err := decodificarDate(ci, src, true)
 	if err != nil {
 		return err
 	}
-
-	switch r.Status {
-	case pgtype.Present:
-		if r.InfinityModifier != pgtype.None {
-			return fmt.Errorf("cannot assign %v", src)
-		}
-		*t = NewFechaFromTime(r.Time)
-		return nil
-	case pgtype.Null:
-		return pgtype.NullAssignTo(t)
-	}
-
-	return fmt.Errorf("cannot decode %#v", src)
-	// type compatibility is checked by AssignTo
-	// only lossless assignments will succeed
-	// if err := r.AssignTo(&t); err != nil {
-	// 	return err
-	// }
-
-	// return nil
+	*t = f
+	return nil
 }
 
+// EncodeBinary guarda la fecha como DATE. La fecha cero se guarda como NULL.
 func (src Fecha) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
-
-	d := pgtype.Date{}
-
-	if src == 0 {
-		d.Status = pgtype.Null
-	} else {
-		d.Status = pgtype.Present
-		d.Time = src.Time()
+	d, err := src.date()
+	if err != nil {
+		return nil, err
 	}
 	return d.EncodeBinary(ci, buf)
 }
 
+// DecodeText lee un DATE en formato texto ("2020-08-23"), que es el que
+// utiliza pgx en el protocolo simple. NULL se lee como fecha cero.
 func (t *Fecha) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
-
-	if src == nil {
-		return nil
-	}
-	if len(src) == 0 {
-		return nil
+	f, err := decodificarDate(ci, src, false)
+	if err != nil {
+		return err
 	}
+	*t = f
 	return nil
-	// Es time?
-	// t, ok := src.(time.Time)
-	// if ok {
-	// 	*f = NewFechaFromTime(t)
-	// 	return nil
-	// }
-
-	// // Tiene interface Time?
-	// tInterface, ok := value.(Time)
-	// if ok {
-	// 	*f = NewFechaFromTime(tInterface.Time())
-	// 	return nil
-	// }
-
-	// return nil
 }
 
-func (src *Fecha) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
-	d := pgtype.Date{}
-
-	if src == nil {
-		d.Status = pgtype.Null
-	} else {
-		d.Status = pgtype.Present
-		d.Time = src.Time()
+// EncodeText guarda la fecha como texto "2020-08-23".
+// La fecha cero se guarda como NULL.
+func (src Fecha) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := src.date()
+	if err != nil {
+		return nil, err
 	}
 	return d.EncodeText(ci, buf)
 }
 
-// NewTypeValue creates a TypeValue including references to internal type information. e.g. the list of members
-// in an EnumType.
-// func (t Fecha) NewTypeValue() Fecha {
-
-// }
-
 // TypeName returns the PostgreSQL name of this type.
 func (Fecha) TypeName() string {
 	return "date"
 }
 
 func (t *Fecha) NewTypeValue() pgtype.Value {
-	return t
+	return new(Fecha)
 }
 
+// Set acepta una Fecha, un time.Time, un string con formato 2006-01-02,
+// un pgtype.Date o cualquier valor que tenga el método Time() (y punteros a ellos).
+// nil se toma como fecha cero.
 func (t *Fecha) Set(src interface{}) error {
-	return fmt.Errorf("cannot convert %v to Point", src)
+	switch v := src.(type) {
+	case nil:
+		*t = 0
+	case Fecha:
+		*t = v
+	case *Fecha:
+		if v == nil {
+			*t = 0
+			return nil
+		}
+		*t = *v
+	case time.Time:
+		*t = NewFechaFromTime(v)
+	case *time.Time:
+		if v == nil {
+			*t = 0
+			return nil
+		}
+		*t = NewFechaFromTime(*v)
+	case string:
+		f, err := NewFecha(v)
+		if err != nil {
+			return err
+		}
+		*t = f
+	case *string:
+		if v == nil {
+			*t = 0
+			return nil
+		}
+		return t.Set(*v)
+	case pgtype.Date:
+		f, err := fechaDesdeDate(v)
+		if err != nil {
+			return err
+		}
+		*t = f
+	case Time:
+		*t = NewFechaFromTime(v.Time())
+	default:
+		return fmt.Errorf("cannot convert %v to Fecha", src)
+	}
+	return nil
 }
+
+// Get devuelve la fecha, o nil si es cero.
 func (t *Fecha) Get() interface{} {
+	if *t == 0 {
+		return nil
+	}
 	return *t
 }
+
+// AssignTo asigna la fecha a *Fecha, *time.Time, *string ("2020-08-23"),
+// *pgtype.Date o punteros a punteros de ellos. Si la fecha es cero, los
+// punteros a punteros quedan en nil; *time.Time y *string devuelven error.
+// Infinito y MenosInfinito sólo se pueden asignar a *Fecha, *pgtype.Date y
+// *string ("infinity" y "-infinity").
 func (t *Fecha) AssignTo(dst interface{}) error {
+	switch v := dst.(type) {
+	case *Fecha:
+		*v = *t
+		return nil
+	case *pgtype.Date:
+		d, err := t.date()
+		if err != nil {
+			return err
+		}
+		*v = d
+		return nil
+	}
+
+	if *t == 0 {
+		return pgtype.NullAssignTo(dst)
+	}
+	if v, ok := dst.(*string); ok && t.IsInfinite() {
+		*v = t.JSONString()
+		return nil
+	}
+	if !t.IsValid() {
+		return fmt.Errorf("cannot assign invalid date '%v' to %T", int(*t), dst)
+	}
+
+	switch v := dst.(type) {
+	case *time.Time:
+		*v = t.Time()
+	case *string:
+		*v = t.JSONString()
+	default:
+		if siguiente, ok := pgtype.GetAssignToDstType(dst); ok {
+			return t.AssignTo(siguiente)
+		}
+		return fmt.Errorf("unable to assign to %T", dst)
+	}
 	return nil
 }
+
+// Devuelve la fecha como pgtype.Date. La fecha cero es NULL e Infinito y
+// MenosInfinito son 'infinity' y '-infinity'.
+func (src Fecha) date() (pgtype.Date, error) {
+	switch src {
+	case 0:
+		return pgtype.Date{Status: pgtype.Null}, nil
+	case Infinito:
+		return pgtype.Date{Status: pgtype.Present, InfinityModifier: pgtype.Infinity}, nil
+	case MenosInfinito:
+		return pgtype.Date{Status: pgtype.Present, InfinityModifier: pgtype.NegativeInfinity}, nil
+	}
+	if !src.IsValid() {
+		return pgtype.Date{}, fmt.Errorf("invalid date '%v'", int(src))
+	}
+	return pgtype.Date{Time: src.Time(), Status: pgtype.Present}, nil
+}
+
+// Convierte un pgtype.Date en Fecha. NULL se devuelve como cero e
+// 'infinity' y '-infinity' como Infinito y MenosInfinito.
+func fechaDesdeDate(d pgtype.Date) (Fecha, error) {
+	switch d.Status {
+	case pgtype.Null, pgtype.Undefined:
+		return 0, nil
+	}
+	switch d.InfinityModifier {
+	case pgtype.Infinity:
+		return Infinito, nil
+	case pgtype.NegativeInfinity:
+		return MenosInfinito, nil
+	}
+	return NewFechaFromTime(d.Time), nil
+}
+
+// Decodifica un DATE de postgres. Si es NULL devuelve cero.
+func decodificarDate(ci *pgtype.ConnInfo, src []byte, binario bool) (Fecha, error) {
+	d := pgtype.Date{}
+	var err error
+	if binario {
+		err = d.DecodeBinary(ci, src)
+	} else {
+		err = d.DecodeText(ci, src)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return fechaDesdeDate(d)
+}
@@ -0,0 +1,170 @@
+package fecha
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFechaPgxBinario(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	by, err := Fecha(20200823).EncodeBinary(ci, nil)
+	assert.Nil(err)
+
+	var f Fecha
+	assert.Nil(f.DecodeBinary(ci, by))
+	assert.Equal(Fecha(20200823), f)
+
+	// NULL
+	by, err = Fecha(0).EncodeBinary(ci, nil)
+	assert.Nil(err)
+	assert.Nil(by)
+	assert.Nil(f.DecodeBinary(ci, nil))
+	assert.Equal(Fecha(0), f)
+
+	_, err = Fecha(20200231).EncodeBinary(ci, nil)
+	assert.NotNil(err)
+}
+
+func TestFechaPgxTexto(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	by, err := Fecha(20200823).EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Equal("2020-08-23", string(by))
+
+	var f Fecha
+	assert.Nil(f.DecodeText(ci, []byte("2020-08-23")))
+	assert.Equal(Fecha(20200823), f)
+
+	// NULL
+	by, err = Fecha(0).EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Nil(by)
+	assert.Nil(f.DecodeText(ci, nil))
+	assert.Equal(Fecha(0), f)
+
+	assert.NotNil(f.DecodeText(ci, []byte("23/08/2020")))
+	_, err = Fecha(20201301).EncodeText(ci, nil)
+	assert.NotNil(err)
+}
+
+func TestFechaPgxInfinito(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	for _, v := range []Fecha{Infinito, MenosInfinito} {
+		by, err := v.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		var f Fecha
+		assert.Nil(f.DecodeBinary(ci, by))
+		assert.Equal(v, f)
+
+		by, err = v.EncodeText(ci, nil)
+		assert.Nil(err)
+		assert.Equal(v.JSONString(), string(by))
+		f = 0
+		assert.Nil(f.DecodeText(ci, by))
+		assert.Equal(v, f)
+	}
+
+	var f Fecha
+	assert.Nil(f.Set(pgtype.Date{Status: pgtype.Present, InfinityModifier: pgtype.Infinity}))
+	assert.Equal(Infinito, f)
+	assert.Nil(f.Set(pgtype.Date{Status: pgtype.Present, InfinityModifier: pgtype.NegativeInfinity}))
+	assert.Equal(MenosInfinito, f)
+
+	var texto string
+	assert.Nil(f.AssignTo(&texto))
+	assert.Equal("-infinity", texto)
+	var d pgtype.Date
+	assert.Nil(f.AssignTo(&d))
+	assert.Equal(pgtype.NegativeInfinity, d.InfinityModifier)
+	assert.NotNil(f.AssignTo(&time.Time{}))
+}
+
+func TestFechaPgxSet(t *testing.T) {
+	assert := assert.New(t)
+
+	tm := time.Date(2020, 8, 23, 15, 0, 0, 0, time.UTC)
+	texto := "2020-08-23"
+	otra := Fecha(20200823)
+	for _, v := range []interface{}{
+		Fecha(20200823),
+		&otra,
+		tm,
+		&tm,
+		texto,
+		&texto,
+		pgtype.Date{Time: tm, Status: pgtype.Present},
+	} {
+		var f Fecha
+		assert.Nil(f.Set(v), "%T", v)
+		assert.Equal(Fecha(20200823), f, "%T", v)
+	}
+
+	for _, v := range []interface{}{nil, (*Fecha)(nil), (*time.Time)(nil), (*string)(nil), pgtype.Date{Status: pgtype.Null}} {
+		f := Fecha(20200823)
+		assert.Nil(f.Set(v), "%T", v)
+		assert.Equal(Fecha(0), f, "%T", v)
+	}
+
+	f := Fecha(20200823)
+	assert.NotNil(f.Set(3.5))
+	assert.NotNil(f.Set("23/08/2020"))
+	assert.Equal(Fecha(20200823), f)
+
+	assert.Equal(Fecha(20200823), f.Get())
+	assert.Nil(new(Fecha).Get())
+}
+
+func TestFechaPgxAssignTo(t *testing.T) {
+	assert := assert.New(t)
+	f := Fecha(20200823)
+
+	{
+		var dst Fecha
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal(f, dst)
+	}
+	{
+		var dst time.Time
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal(time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC), dst)
+	}
+	{
+		var dst string
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal("2020-08-23", dst)
+	}
+	{
+		var dst pgtype.Date
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal(pgtype.Present, dst.Status)
+		assert.Equal(time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC), dst.Time)
+	}
+	{ // Puntero a puntero
+		var dst *time.Time
+		assert.Nil(f.AssignTo(&dst))
+		assert.Equal(time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC), *dst)
+	}
+	{ // NULL
+		cero := Fecha(0)
+		dst := &time.Time{}
+		assert.Nil(cero.AssignTo(&dst))
+		assert.Nil(dst)
+
+		var d pgtype.Date
+		assert.Nil(cero.AssignTo(&d))
+		assert.Equal(pgtype.Null, d.Status)
+
+		assert.NotNil(cero.AssignTo(&time.Time{}))
+		assert.NotNil(cero.AssignTo(new(string)))
+	}
+	assert.NotNil(f.AssignTo(new(int)))
+}
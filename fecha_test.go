@@ -1,6 +1,12 @@
 package fecha
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"math"
 	"reflect"
 	"testing"
 	"time"
@@ -289,3 +295,335 @@ func TestMenos(t *testing.T) {
 		assert.Equal(t, 366, dias)
 	}
 }
+
+func TestTimeSeriesMismoMes(t *testing.T) {
+	ts, err := TimeSeries(Fecha(20200805), Fecha(20200823), AgrupacionMensual)
+	assert.Nil(t, err)
+	assert.Equal(t, []Fecha{20200801}, ts)
+}
+
+func TestTimeSeriesInvertida(t *testing.T) {
+	_, err := TimeSeries(Fecha(20200823), Fecha(20200805), AgrupacionMensual)
+	assert.NotNil(t, err)
+
+	_, err = TimeSeries(Fecha(0), Fecha(20200805), AgrupacionMensual)
+	assert.NotNil(t, err)
+
+	_, err = TimeSeries(Fecha(20200801), Fecha(20200805), Agrupacion("Quincenal"))
+	assert.NotNil(t, err)
+}
+
+func TestTimeSeriesAgrupaciones(t *testing.T) {
+	assert := assert.New(t)
+	{
+		ts, err := TimeSeries(Fecha(20201230), Fecha(20210102), AgrupacionDiaria)
+		assert.Nil(err)
+		assert.Equal([]Fecha{20201230, 20201231, 20210101, 20210102}, ts)
+	}
+	{ // 2020-08-05 es miércoles
+		ts, err := TimeSeries(Fecha(20200805), Fecha(20200817), AgrupacionSemanal)
+		assert.Nil(err)
+		assert.Equal([]Fecha{20200803, 20200810, 20200817}, ts)
+	}
+	{ // Semanas que empiezan el domingo
+		ts, err := TimeSeriesSemanal(Fecha(20200805), Fecha(20200817), time.Sunday)
+		assert.Nil(err)
+		assert.Equal([]Fecha{20200802, 20200809, 20200816}, ts)
+	}
+	{
+		ts, err := TimeSeries(Fecha(20201115), Fecha(20210402), AgrupacionTrimestral)
+		assert.Nil(err)
+		assert.Equal([]Fecha{20201001, 20210101, 20210401}, ts)
+	}
+	{
+		ts, err := TimeSeries(Fecha(20201115), Fecha(20210702), AgrupacionSemestral)
+		assert.Nil(err)
+		assert.Equal([]Fecha{20200701, 20210101, 20210701}, ts)
+	}
+	{
+		ts, err := TimeSeries(Fecha(20200229), Fecha(20220101), AgrupacionAnual)
+		assert.Nil(err)
+		assert.Equal([]Fecha{20200101, 20210101, 20220101}, ts)
+	}
+}
+
+func TestAritmeticaCivil(t *testing.T) {
+	// Compara contra package time día por día
+	esperado := time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := Fecha(16000101)
+	for i := 0; i < 365*600; i++ {
+		if f.Time() != esperado || f != NewFechaFromTime(esperado) {
+			t.Fatal("Se esperaba", esperado, "se obtuvo", f)
+		}
+		if Diff(Fecha(19700101), f) != int(esperado.Unix()/86400) {
+			t.Fatal("Diff incorrecto para", f)
+		}
+		if f.diaSemana() != esperado.Weekday() {
+			t.Fatal("Día de la semana incorrecto para", f)
+		}
+		esperado = esperado.AddDate(0, 0, 1)
+		f = f.AgregarDias(1)
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(Fecha(20200229).IsValid())
+	assert.True(Fecha(10000101).IsValid())
+	assert.True(Fecha(99991231).IsValid())
+	assert.False(Fecha(20210229).IsValid())
+	assert.False(Fecha(20200431).IsValid())
+	assert.False(Fecha(20201301).IsValid())
+	assert.False(Fecha(20200100).IsValid())
+	assert.False(Fecha(0).IsValid())
+	assert.False(Fecha(-20200101).IsValid())
+	assert.False(Fecha(9990101).IsValid())
+}
+
+func TestNewFechaFromIntsNormaliza(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Fecha(20200501), NewFechaFromInts(2020, 4, 31))
+	assert.Equal(Fecha(20210101), NewFechaFromInts(2020, 13, 1))
+	assert.Equal(Fecha(20191231), NewFechaFromInts(2020, 1, 0))
+	assert.Equal(Fecha(20191201), NewFechaFromInts(2020, 0, 1))
+}
+
+func TestAgregarMesesCambioDeAño(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Fecha(20170228), Fecha(20161231).AgregarMeses(2))
+	assert.Equal(Fecha(20160229), Fecha(20170131).AgregarMeses(-11))
+	assert.Equal(Fecha(20151130), Fecha(20170131).AgregarMeses(-14))
+}
+
+func TestAgregarAños(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Fecha(20210823), Fecha(20200823).AgregarAños(1))
+	assert.Equal(Fecha(20210301), Fecha(20200229).AgregarAños(1))
+	assert.Equal(Fecha(20240229), Fecha(20200229).AgregarAños(4))
+	assert.Equal(Fecha(0), Fecha(20200229).AgregarAños(8000))
+}
+
+func TestStringYJSONString(t *testing.T) {
+	assert := assert.New(t)
+	f := Fecha(20200823)
+	assert.Equal("23/08/2020", f.String())
+	assert.Equal("2020-08-23", f.JSONString())
+	assert.Equal("01/01/0001", Fecha(0).String())
+	assert.Equal("N/A", Fecha(20200231).String())
+	assert.Equal("Domingo", f.DiaDeLaSemana())
+}
+
+func TestMarshalTextFecha(t *testing.T) {
+	assert := assert.New(t)
+
+	by, err := Fecha(20200823).MarshalText()
+	assert.Nil(err)
+	assert.Equal("2020-08-23", string(by))
+
+	by, err = Fecha(0).MarshalText()
+	assert.Nil(err)
+	assert.Equal("", string(by))
+
+	_, err = Fecha(20200231).MarshalText()
+	assert.NotNil(err)
+
+	f := Fecha(20200101)
+	assert.Nil(f.UnmarshalText([]byte("2020-08-23")))
+	assert.Equal(Fecha(20200823), f)
+	assert.NotNil(f.UnmarshalText([]byte("2020-02-31")))
+	assert.Equal(Fecha(20200823), f)
+	assert.Nil(f.UnmarshalText(nil))
+	assert.Equal(Fecha(0), f)
+
+	{ // Clave de un map en JSON
+		m := map[Fecha]int{20200823: 1, 20200101: 2}
+		by, err := json.Marshal(m)
+		assert.Nil(err)
+		assert.Equal(`{"2020-01-01":2,"2020-08-23":1}`, string(by))
+
+		leido := map[Fecha]int{}
+		assert.Nil(json.Unmarshal(by, &leido))
+		assert.Equal(m, leido)
+	}
+	{ // Atributo XML
+		type evento struct {
+			Fecha Fecha `xml:"fecha,attr"`
+		}
+		by, err := xml.Marshal(evento{20200823})
+		assert.Nil(err)
+		assert.Equal(`<evento fecha="2020-08-23"></evento>`, string(by))
+
+		e := evento{}
+		assert.Nil(xml.Unmarshal(by, &e))
+		assert.Equal(Fecha(20200823), e.Fecha)
+	}
+	{ // flag
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		var desde Fecha
+		fs.TextVar(&desde, "desde", Fecha(20200101), "fecha desde")
+		assert.Nil(fs.Parse([]string{"-desde", "2020-08-23"}))
+		assert.Equal(Fecha(20200823), desde)
+	}
+}
+
+func TestMarshalBinaryFecha(t *testing.T) {
+	assert := assert.New(t)
+
+	by, err := Fecha(20200823).MarshalBinary()
+	assert.Nil(err)
+	assert.Equal([]byte{0x01, 0x34, 0x3d, 0x77}, by)
+
+	for _, v := range []Fecha{0, 20200823, 10000101, 99991231} {
+		by, err := v.MarshalBinary()
+		assert.Nil(err)
+		f := Fecha(1)
+		assert.Nil(f.UnmarshalBinary(by))
+		assert.Equal(v, f)
+	}
+
+	_, err = Fecha(20201301).MarshalBinary()
+	assert.NotNil(err)
+
+	f := Fecha(20200823)
+	assert.NotNil(f.UnmarshalBinary([]byte{1, 2, 3}))
+	assert.NotNil(f.UnmarshalBinary([]byte{0, 0, 0, 1}))
+	assert.Equal(Fecha(20200823), f)
+
+	{ // gob
+		type registro struct {
+			Fecha Fecha
+			Mes   Mes
+		}
+		buf := bytes.Buffer{}
+		r := registro{20200823, Mes{2020, 8}}
+		assert.Nil(gob.NewEncoder(&buf).Encode(r))
+
+		leido := registro{}
+		assert.Nil(gob.NewDecoder(&buf).Decode(&leido))
+		assert.Equal(r, leido)
+	}
+}
+
+func TestInfinito(t *testing.T) {
+	assert := assert.New(t)
+	f := Fecha(20200823)
+
+	assert.True(MenosInfinito < f && f < Infinito)
+	assert.True(Infinito.IsInfinite())
+	assert.False(Infinito.IsValid())
+	assert.False(f.IsInfinite())
+
+	assert.Equal(Infinito, Infinito.AgregarDias(-10))
+	assert.Equal(MenosInfinito, MenosInfinito.AgregarMeses(3))
+	assert.Equal(Infinito, Infinito.AgregarAños(1))
+
+	assert.Equal(math.MaxInt, Diff(f, Infinito))
+	assert.Equal(math.MinInt, Diff(f, MenosInfinito))
+	assert.Equal(math.MinInt, Diff(Infinito, f))
+	assert.Equal(math.MaxInt, Diff(MenosInfinito, Infinito))
+	assert.Equal(0, Diff(Infinito, Infinito))
+	assert.Equal(math.MaxInt, Infinito.Menos(f))
+
+	assert.Equal("infinito", Infinito.String())
+	assert.Equal("-infinito", MenosInfinito.String())
+	assert.Equal("infinito", Infinito.Format("02/01/2006"))
+	assert.Equal(MesInfinito(), Infinito.PeriodoMes())
+
+	// Las funciones entre dos fechas siguen el criterio de Diff
+	assert.Equal(math.MaxInt, MesesCompletos(f, Infinito))
+	assert.Equal(math.MinInt, AñosCompletos(f, MenosInfinito))
+	assert.Equal(0, MesesCompletos(Infinito, Infinito))
+	assert.Equal(Diferencia{Años: math.MaxInt}, DiferenciaEntre(MenosInfinito, f))
+	assert.Equal(math.MaxInt, DiasHabilesEntre(f, Infinito, nil))
+	assert.Equal(math.MinInt, DiasBase(Infinito, f, Base30360US))
+	fraccion, err := FraccionAño(f, Infinito, BaseACT365Fijo)
+	assert.Nil(err)
+	assert.True(math.IsInf(fraccion, 1))
+	fraccion, err = FraccionAño(Infinito, f, BaseACTACTISDA)
+	assert.Nil(err)
+	assert.True(math.IsInf(fraccion, -1))
+	assert.Equal(NilSemana, Infinito.Semana())
+	assert.Equal(NilSemana, MenosInfinito.Semana())
+
+	// Días hábiles, ajustes y períodos
+	cal := NewCalendarioFeriados(Feriado{Fecha: 20200824})
+	for _, inf := range []Fecha{Infinito, MenosInfinito} {
+		assert.False(inf.EsHabil(nil))
+		assert.False(inf.EsHabil(cal))
+		assert.False(cal.EsHabil(inf))
+		assert.False(SoloFinesDeSemana{}.EsHabil(inf))
+		assert.Equal(inf, inf.ProximoDiaHabil(cal))
+		assert.Equal(inf, inf.DiaHabilAnterior(nil))
+		assert.Equal(inf, inf.AgregarDiasHabiles(5))
+		assert.Equal(inf, inf.AgregarDiasHabilesCalendario(-5, cal))
+		for _, a := range []Ajuste{AjusteSiguiente, AjusteSiguienteModificado, AjusteAnterior, AjusteAnteriorModificado} {
+			assert.Equal(inf, inf.Ajustar(a, cal))
+		}
+		assert.False(inf.EsFinDeMes())
+		assert.Equal(inf, inf.AgregarMesesFinDeMes(1))
+		assert.Equal(NilTrimestre, inf.PeriodoTrimestre())
+		assert.Equal(NilSemestre, inf.PeriodoSemestre())
+		assert.Equal(NilAño, inf.PeriodoAño())
+	}
+}
+
+func TestInfinitoSerializacion(t *testing.T) {
+	assert := assert.New(t)
+
+	for texto, f := range map[string]Fecha{"infinity": Infinito, "-infinity": MenosInfinito} {
+		by, err := json.Marshal(f)
+		assert.Nil(err)
+		assert.Equal(`"`+texto+`"`, string(by))
+		var leida Fecha
+		assert.Nil(json.Unmarshal(by, &leida))
+		assert.Equal(f, leida)
+
+		by, err = f.MarshalText()
+		assert.Nil(err)
+		assert.Equal(texto, string(by))
+
+		nueva, err := NewFecha(texto)
+		assert.Nil(err)
+		assert.Equal(f, nueva)
+
+		by, err = f.MarshalBinary()
+		assert.Nil(err)
+		leida = 0
+		assert.Nil(leida.UnmarshalBinary(by))
+		assert.Equal(f, leida)
+
+		v, err := f.Value()
+		assert.Nil(err)
+		assert.Equal(texto, v)
+		leida = 0
+		assert.Nil(leida.Scan([]byte(texto)))
+		assert.Equal(f, leida)
+	}
+}
+
+func TestSinAllocs(t *testing.T) {
+	f := Fecha(20200823)
+	allocs := testing.AllocsPerRun(100, func() {
+		f2 := f.AgregarDias(400).AgregarMeses(-5).AgregarAños(2)
+		_ = Diff(f, f2) + f.Menos(f2)
+		_ = f2.Dia() + f2.Mes() + f2.Año()
+		_ = f2.IsValid()
+		_ = f2.Time()
+		_ = f2.DiaDeLaSemana()
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func BenchmarkAgregarDias(b *testing.B) {
+	f := Fecha(20200823)
+	for i := 0; i < b.N; i++ {
+		_ = f.AgregarDias(i % 1000)
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	f := Fecha(20200823)
+	for i := 0; i < b.N; i++ {
+		_ = f.String()
+	}
+}
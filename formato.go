@@ -0,0 +1,242 @@
+package fecha
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Format devuelve la fecha con el layout especificado.
+//
+// El layout funciona igual que el de time.Format, tomando como referencia el
+// lunes 2 de enero de 2006, pero los nombres se escriben en castellano:
+//
+//	2006   año con cuatro dígitos       06     año con dos dígitos
+//	01     mes con dos dígitos          1      mes sin cero a la izquierda
+//	enero  nombre del mes               ene    nombre del mes abreviado
+//	02     día con dos dígitos          2      día sin cero a la izquierda
+//	_2     día completado con espacio
+//	lunes  día de la semana             lun    día de la semana abreviado
+//
+// Los nombres respetan las mayúsculas del layout: "enero" => "agosto",
+// "Enero" => "Agosto", "ENERO" => "AGOSTO". También se aceptan los nombres en
+// inglés de time.Format (January, Jan, Monday, Mon), que se escriben en
+// castellano con la primera letra en mayúscula. Para otros idiomas ver FormatIdioma.
+//
+// Los nombres sólo se reconocen como palabras completas, por lo que el texto
+// literal puede contener palabras como "Generado" o "tiene" sin que se
+// reemplace "ene".
+//
+// Por ejemplo:
+//
+//	f.Format("lunes 2 de enero de 2006") // "domingo 23 de agosto de 2020"
+//	f.Format("ene-06")                   // "ago-20"
+//
+// Infinito y MenosInfinito devuelven "infinito" y "-infinito" con cualquier
+// layout. Si la fecha no es válida devuelve un string vacío.
+func (f Fecha) Format(layout string) string {
+	return f.FormatIdioma(IdiomaPorDefecto, layout)
+}
+
+// FormatIdioma es igual a Format, pero escribe los nombres en el idioma
+// indicado (por ejemplo "pt-BR" o "en"). Con los nombres en minúscula del
+// layout, se respetan las mayúsculas propias del idioma:
+//
+//	f.FormatIdioma("en", "lunes, enero 2, 2006") // "Sunday, August 23, 2020"
+//
+// Si el idioma no está registrado se utiliza IdiomaPorDefecto.
+func (f Fecha) FormatIdioma(idioma, layout string) string {
+	if f.IsInfinite() {
+		return f.String()
+	}
+	if !f.IsValid() {
+		return ""
+	}
+	año, mes, dia := f.partes()
+	by := make([]byte, 0, len(layout)+10)
+	by = formatear(by, layout, año, mes, dia, f.diaSemana(), &idiomaOPorDefecto(idioma).Idioma)
+	return string(by)
+}
+
+// DiaDeLaSemanaIdioma devuelve el nombre del día de la semana en el idioma
+// indicado, con la primera letra en mayúscula.
+// Si el idioma no está registrado se utiliza IdiomaPorDefecto.
+func (f Fecha) DiaDeLaSemanaIdioma(idioma string) string {
+	if !f.IsValid() {
+		return f.FormatIdioma(idioma, "Lunes")
+	}
+	return idiomaOPorDefecto(idioma).dias[f.diaSemana()]
+}
+
+// Format devuelve el mes con el layout especificado.
+// Utiliza los mismos elementos que Fecha.Format, por ejemplo:
+//
+//	m.Format("enero 2006") // "agosto 2020"
+//	m.Format("ene/06")     // "ago/20"
+//
+// Los elementos del día se completan con el primer día del mes.
+// MesInfinito y MesMenosInfinito devuelven "infinito" y "-infinito".
+// Si el mes no es válido devuelve un string vacío.
+func (m Mes) Format(layout string) string {
+	return m.FormatIdioma(IdiomaPorDefecto, layout)
+}
+
+// FormatIdioma es igual a Format, pero escribe los nombres en el idioma
+// indicado. Si el idioma no está registrado se utiliza IdiomaPorDefecto.
+func (m Mes) FormatIdioma(idioma, layout string) string {
+	if m.IsInfinite() {
+		return m.String()
+	}
+	if !m.Valid() {
+		return ""
+	}
+	return m.PrimerDia().FormatIdioma(idioma, layout)
+}
+
+type tipoElemento int
+
+const (
+	elementoAño tipoElemento = iota + 1
+	elementoAñoCorto
+	elementoMes
+	elementoMesSinCero
+	elementoNombreMes
+	elementoNombreMesAbreviado
+	elementoDia
+	elementoDiaSinCero
+	elementoDiaConEspacio
+	elementoDiaSemana
+	elementoDiaSemanaAbreviado
+)
+
+type mayusculas int
+
+const (
+	minusculas mayusculas = iota
+	primeraMayuscula
+	todoMayusculas
+)
+
+type elemento struct {
+	texto      string
+	tipo       tipoElemento
+	mayusculas mayusculas
+}
+
+// Elementos que se reconocen en un layout. Los más largos van primero
+// para que "2006" no se interprete como "2" y "006".
+var elementosLayout = []elemento{
+	{"2006", elementoAño, minusculas},
+	{"January", elementoNombreMes, primeraMayuscula},
+	{"Monday", elementoDiaSemana, primeraMayuscula},
+	{"enero", elementoNombreMes, minusculas},
+	{"Enero", elementoNombreMes, primeraMayuscula},
+	{"ENERO", elementoNombreMes, todoMayusculas},
+	{"lunes", elementoDiaSemana, minusculas},
+	{"Lunes", elementoDiaSemana, primeraMayuscula},
+	{"LUNES", elementoDiaSemana, todoMayusculas},
+	{"Jan", elementoNombreMesAbreviado, primeraMayuscula},
+	{"Mon", elementoDiaSemanaAbreviado, primeraMayuscula},
+	{"ene", elementoNombreMesAbreviado, minusculas},
+	{"Ene", elementoNombreMesAbreviado, primeraMayuscula},
+	{"ENE", elementoNombreMesAbreviado, todoMayusculas},
+	{"lun", elementoDiaSemanaAbreviado, minusculas},
+	{"Lun", elementoDiaSemanaAbreviado, primeraMayuscula},
+	{"LUN", elementoDiaSemanaAbreviado, todoMayusculas},
+	{"_2", elementoDiaConEspacio, minusculas},
+	{"01", elementoMes, minusculas},
+	{"02", elementoDia, minusculas},
+	{"06", elementoAñoCorto, minusculas},
+	{"1", elementoMesSinCero, minusculas},
+	{"2", elementoDiaSinCero, minusculas},
+}
+
+// Busca el próximo elemento del layout. Devuelve el texto literal anterior
+// al elemento y lo que queda del layout. Si no hay más elementos, ok es false.
+// Los nombres ("enero", "lun", ...) sólo se reconocen como palabras completas,
+// para que "Generado" o "tiene" no se tomen como "ene".
+func proximoElemento(layout string) (literal string, e elemento, resto string, ok bool) {
+	for i := 0; i < len(layout); i++ {
+		for _, v := range elementosLayout {
+			if !strings.HasPrefix(layout[i:], v.texto) {
+				continue
+			}
+			fin := i + len(v.texto)
+			if empiezaConLetra(v.texto) && (terminaEnLetra(layout[:i]) || empiezaConLetra(layout[fin:])) {
+				continue
+			}
+			return layout[:i], v, layout[fin:], true
+		}
+	}
+	return layout, e, "", false
+}
+
+func empiezaConLetra(texto string) bool {
+	r, _ := utf8.DecodeRuneInString(texto)
+	return unicode.IsLetter(r)
+}
+
+func terminaEnLetra(texto string) bool {
+	r, _ := utf8.DecodeLastRuneInString(texto)
+	return unicode.IsLetter(r)
+}
+
+func formatear(by []byte, layout string, año, mes, dia int, diaSemana time.Weekday, n *Idioma) []byte {
+	for layout != "" {
+		literal, e, resto, ok := proximoElemento(layout)
+		by = append(by, literal...)
+		if !ok {
+			break
+		}
+		layout = resto
+
+		switch e.tipo {
+		case elementoAño:
+			by = appendCuatroDigitos(by, año)
+		case elementoAñoCorto:
+			by = appendDosDigitos(by, año%100)
+		case elementoMes:
+			by = appendDosDigitos(by, mes)
+		case elementoMesSinCero:
+			by = appendSinCero(by, mes)
+		case elementoNombreMes:
+			by = appendNombre(by, n.Meses[mes-1], e.mayusculas)
+		case elementoNombreMesAbreviado:
+			by = appendNombre(by, n.MesesAbreviados[mes-1], e.mayusculas)
+		case elementoDia:
+			by = appendDosDigitos(by, dia)
+		case elementoDiaSinCero:
+			by = appendSinCero(by, dia)
+		case elementoDiaConEspacio:
+			if dia < 10 {
+				by = append(by, ' ')
+			}
+			by = appendSinCero(by, dia)
+		case elementoDiaSemana:
+			by = appendNombre(by, n.Dias[diaSemana], e.mayusculas)
+		case elementoDiaSemanaAbreviado:
+			by = appendNombre(by, n.DiasAbreviados[diaSemana], e.mayusculas)
+		}
+	}
+	return by
+}
+
+func appendSinCero(by []byte, n int) []byte {
+	if n < 10 {
+		return append(by, byte('0'+n))
+	}
+	return appendDosDigitos(by, n)
+}
+
+func appendNombre(by []byte, nombre string, m mayusculas) []byte {
+	switch m {
+	case primeraMayuscula:
+		r, tamaño := utf8.DecodeRuneInString(nombre)
+		by = utf8.AppendRune(by, unicode.ToUpper(r))
+		return append(by, nombre[tamaño:]...)
+	case todoMayusculas:
+		return append(by, strings.ToUpper(nombre)...)
+	}
+	return append(by, nombre...)
+}
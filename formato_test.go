@@ -0,0 +1,51 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	assert := assert.New(t)
+	f := Fecha(20200823) // Domingo
+
+	assert.Equal("domingo 23 de agosto de 2020", f.Format("lunes 2 de enero de 2006"))
+	assert.Equal("Domingo 23 de Agosto de 2020", f.Format("Lunes 2 de Enero de 2006"))
+	assert.Equal("DOMINGO 23 DE AGOSTO", f.Format("LUNES 2 DE ENERO"))
+	assert.Equal("ago-20", f.Format("ene-06"))
+	assert.Equal("Dom 23/Ago/2020", f.Format("Lun 02/Ene/2006"))
+	assert.Equal("23/08/2020", f.Format("02/01/2006"))
+	assert.Equal("2020-08-23", f.Format("2006-01-02"))
+	assert.Equal("Domingo, 23 de Agosto", f.Format("Monday, 2 de January"))
+	assert.Equal("Dom Ago", f.Format("Mon Jan"))
+
+	f = Fecha(20210306) // Sábado
+	assert.Equal("6/3/21", f.Format("2/1/06"))
+	assert.Equal(" 6-03", f.Format("_2-01"))
+	assert.Equal("SÁBADO", f.Format("LUNES"))
+	assert.Equal("Sáb", f.Format("Lun"))
+	assert.Equal("miércoles", Fecha(20210310).Format("lunes"))
+
+	// Los nombres sólo se reemplazan como palabras completas
+	f = Fecha(20200823)
+	assert.Equal("Generado el 23/08/2020, tiene domingo", f.Format("Generado el 02/01/2006, tiene lunes"))
+	assert.Equal("Lunetas y enerogía: ago", f.Format("Lunetas y enerogía: ene"))
+	assert.Equal("Plazo: Tenencia hasta 2020", f.Format("Plazo: Tenencia hasta 2006"))
+	assert.Equal("Montevideo, domingo", f.Format("Montevideo, lunes"))
+	assert.Equal("(agosto)", f.Format("(enero)"))
+	assert.Equal("ago2020", f.Format("ene2006"))
+
+	assert.Equal("", Fecha(0).Format("02/01/2006"))
+	assert.Equal("", Fecha(20210229).Format("02/01/2006"))
+}
+
+func TestFormatMes(t *testing.T) {
+	assert := assert.New(t)
+	m := NewMesMust(2020, 8)
+	assert.Equal("agosto 2020", m.Format("enero 2006"))
+	assert.Equal("ago/20", m.Format("ene/06"))
+	assert.Equal("Agosto de 2020", m.Format("Enero de 2006"))
+	assert.Equal("2020-08", m.Format("2006-01"))
+	assert.Equal("", Mes{}.Format("2006-01"))
+}
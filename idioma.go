@@ -0,0 +1,269 @@
+package fecha
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Idioma contiene los nombres de meses y días de la semana que se utilizan
+// para formatear y parsear fechas.
+//
+// Los nombres se escriben como corresponde en el idioma (por ejemplo "agosto"
+// en castellano y "August" en inglés). Los layouts de Format pueden pedirlos
+// con la primera letra o todo en mayúsculas.
+type Idioma struct {
+	// Codigo identifica al idioma, por ejemplo "es-AR".
+	Codigo string
+
+	Meses           [12]string
+	MesesAbreviados [12]string
+
+	// El primero es el domingo, igual que en time.Weekday.
+	Dias           [7]string
+	DiasAbreviados [7]string
+
+	// AliasMeses son otros nombres de mes aceptados al parsear,
+	// por ejemplo "setiembre" o "sept".
+	AliasMeses map[string]int
+
+	// Conectores son las palabras que se ignoran al parsear,
+	// por ejemplo "de" en castellano u "of" y "th" en inglés.
+	Conectores []string
+
+	// MesAntesQueDia indica que en las fechas numéricas el mes va antes que el
+	// día (08/23/2020). No se hereda al buscar una variante regional por su
+	// idioma base: "en-GB" usa los nombres de "en" pero con el día primero.
+	MesAntesQueDia bool
+}
+
+// IdiomaPorDefecto es el código del idioma que se utiliza cuando no se
+// especifica otro o cuando se pide uno que no está registrado.
+const IdiomaPorDefecto = "es-AR"
+
+// Idioma registrado, con las tablas para parsear ya armadas.
+type idiomaRegistrado struct {
+	Idioma
+	meses     map[string]int
+	ignoradas map[string]bool
+
+	// Nombres de los días con la primera letra en mayúscula.
+	dias [7]string
+}
+
+var idiomas = struct {
+	sync.RWMutex
+	porCodigo map[string]*idiomaRegistrado
+}{
+	porCodigo: map[string]*idiomaRegistrado{},
+}
+
+// Códigos que se resuelven como otro idioma registrado. Se buscan en cada
+// consulta, así que siguen al idioma aunque se lo vuelva a registrar.
+// Un idioma registrado con el mismo código tiene prioridad sobre el alias.
+var aliasIdiomas = map[string]string{
+	"es":    "es-ar",
+	"pt":    "pt-br",
+	"en-us": "en",
+}
+
+// RegistrarIdioma agrega un idioma (o reemplaza uno existente con el mismo código)
+// para que pueda utilizarse al formatear y parsear.
+func RegistrarIdioma(i Idioma) error {
+	codigo := normalizarCodigo(i.Codigo)
+	if codigo == "" {
+		return fmt.Errorf("language code is required")
+	}
+	for k, v := range i.Meses {
+		if v == "" || i.MesesAbreviados[k] == "" {
+			return fmt.Errorf("language '%v': missing name for month %v", i.Codigo, k+1)
+		}
+	}
+	for k, v := range i.Dias {
+		if v == "" || i.DiasAbreviados[k] == "" {
+			return fmt.Errorf("language '%v': missing name for weekday %v", i.Codigo, k)
+		}
+	}
+
+	r := &idiomaRegistrado{
+		Idioma:    i,
+		meses:     map[string]int{},
+		ignoradas: map[string]bool{},
+	}
+	for k, v := range i.Dias {
+		r.dias[k] = string(appendNombre(nil, v, primeraMayuscula))
+	}
+	for k, v := range i.Meses {
+		r.meses[normalizarPalabra(v)] = k + 1
+		r.meses[normalizarPalabra(i.MesesAbreviados[k])] = k + 1
+	}
+	for k, v := range i.AliasMeses {
+		if v < 1 || v > 12 {
+			return fmt.Errorf("language '%v': invalid month %v for alias '%v'", i.Codigo, v, k)
+		}
+		r.meses[normalizarPalabra(k)] = v
+	}
+	palabras := append([]string{}, i.Conectores...)
+	palabras = append(palabras, i.Dias[:]...)
+	palabras = append(palabras, i.DiasAbreviados[:]...)
+	for _, v := range palabras {
+		for _, palabra := range separarTexto(v) {
+			r.ignoradas[palabra] = true
+		}
+	}
+
+	idiomas.Lock()
+	defer idiomas.Unlock()
+	idiomas.porCodigo[codigo] = r
+	return nil
+}
+
+// ObtenerIdioma devuelve el idioma registrado con el código.
+// Si no hay uno con el código exacto ("es-MX"), busca el idioma base ("es"),
+// pero con las fechas numéricas día primero (ver MesAntesQueDia).
+func ObtenerIdioma(codigo string) (Idioma, bool) {
+	r, ok := buscarIdioma(codigo)
+	if !ok {
+		return Idioma{}, false
+	}
+	return r.Idioma, true
+}
+
+func buscarIdioma(codigo string) (*idiomaRegistrado, bool) {
+	// Se normaliza sobre un arreglo en el stack para que buscar el idioma
+	// (por ejemplo en DiaDeLaSemana) no aloque memoria.
+	var buf [16]byte
+	clave := appendCodigo(buf[:0], codigo)
+
+	idiomas.RLock()
+	defer idiomas.RUnlock()
+
+	r, ok := buscarCodigo(clave)
+	if ok {
+		return r, true
+	}
+	base, _, _ := bytes.Cut(clave, []byte("-"))
+	r, ok = buscarCodigo(base)
+	if !ok || !r.MesAntesQueDia {
+		return r, ok
+	}
+	regional := *r
+	regional.MesAntesQueDia = false
+	return &regional, true
+}
+
+// Busca el idioma registrado con el código normalizado o con su alias.
+func buscarCodigo(clave []byte) (*idiomaRegistrado, bool) {
+	r, ok := idiomas.porCodigo[string(clave)]
+	if ok {
+		return r, true
+	}
+	alias, ok := aliasIdiomas[string(clave)]
+	if !ok {
+		return nil, false
+	}
+	r, ok = idiomas.porCodigo[alias]
+	return r, ok
+}
+
+// Devuelve el idioma registrado o el idioma por defecto.
+func idiomaOPorDefecto(codigo string) *idiomaRegistrado {
+	r, ok := buscarIdioma(codigo)
+	if !ok {
+		r, _ = buscarIdioma(IdiomaPorDefecto)
+	}
+	return r
+}
+
+func normalizarCodigo(codigo string) string {
+	return string(appendCodigo(nil, codigo))
+}
+
+// Agrega el código en minúsculas y con "-" como separador ("pt_BR" => "pt-br").
+func appendCodigo(by []byte, codigo string) []byte {
+	for _, c := range []byte(strings.TrimSpace(codigo)) {
+		switch {
+		case c == '_':
+			c = '-'
+		case 'A' <= c && c <= 'Z':
+			c += 'a' - 'A'
+		}
+		by = append(by, c)
+	}
+	return by
+}
+
+// Castellano rioplatense.
+var idiomaCastellano = Idioma{
+	Codigo: "es-AR",
+	Meses: [12]string{
+		"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio",
+		"agosto", "septiembre", "octubre", "noviembre", "diciembre",
+	},
+	MesesAbreviados: [12]string{
+		"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic",
+	},
+	Dias: [7]string{
+		"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado",
+	},
+	DiasAbreviados: [7]string{
+		"dom", "lun", "mar", "mié", "jue", "vie", "sáb",
+	},
+	AliasMeses: map[string]int{
+		"setiembre": 9, "sept": 9, "set": 9,
+	},
+	// Ordinales: 1ro, 1er, 1o
+	Conectores: []string{"de", "del", "el", "ro", "er", "o"},
+}
+
+// Portugués de Brasil.
+var idiomaPortugues = Idioma{
+	Codigo: "pt-BR",
+	Meses: [12]string{
+		"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho",
+		"agosto", "setembro", "outubro", "novembro", "dezembro",
+	},
+	MesesAbreviados: [12]string{
+		"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez",
+	},
+	Dias: [7]string{
+		"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado",
+	},
+	DiasAbreviados: [7]string{
+		"dom", "seg", "ter", "qua", "qui", "sex", "sáb",
+	},
+	Conectores: []string{"de", "do", "o"},
+}
+
+// Inglés, con el orden de fechas numéricas de Estados Unidos.
+var idiomaIngles = Idioma{
+	Codigo: "en",
+	Meses: [12]string{
+		"January", "February", "March", "April", "May", "June", "July",
+		"August", "September", "October", "November", "December",
+	},
+	MesesAbreviados: [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	},
+	Dias: [7]string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	},
+	DiasAbreviados: [7]string{
+		"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+	},
+	AliasMeses: map[string]int{
+		"sept": 9,
+	},
+	// Ordinales: 1st, 2nd, 3rd, 4th
+	Conectores:     []string{"of", "the", "st", "nd", "rd", "th"},
+	MesAntesQueDia: true,
+}
+
+func init() {
+	for _, v := range []Idioma{idiomaCastellano, idiomaPortugues, idiomaIngles} {
+		if err := RegistrarIdioma(v); err != nil {
+			panic(err)
+		}
+	}
+}
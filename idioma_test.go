@@ -0,0 +1,168 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObtenerIdioma(t *testing.T) {
+	assert := assert.New(t)
+
+	i, ok := ObtenerIdioma("es-AR")
+	assert.True(ok)
+	assert.Equal("agosto", i.Meses[7])
+
+	i, ok = ObtenerIdioma("pt_br")
+	assert.True(ok)
+	assert.Equal("pt-BR", i.Codigo)
+
+	// Idioma base
+	i, ok = ObtenerIdioma("es-MX")
+	assert.True(ok)
+	assert.Equal("enero", i.Meses[0])
+
+	i, ok = ObtenerIdioma("en-GB")
+	assert.True(ok)
+	assert.Equal("en", i.Codigo)
+	assert.False(i.MesAntesQueDia)
+
+	// Alias
+	i, ok = ObtenerIdioma("es")
+	assert.True(ok)
+	assert.Equal("es-AR", i.Codigo)
+
+	i, ok = ObtenerIdioma("en-US")
+	assert.True(ok)
+	assert.True(i.MesAntesQueDia)
+
+	_, ok = ObtenerIdioma("de")
+	assert.False(ok)
+}
+
+func TestFormatIdioma(t *testing.T) {
+	assert := assert.New(t)
+	f := Fecha(20200823) // Domingo
+
+	assert.Equal("domingo, 23 de agosto de 2020", f.FormatIdioma("es-AR", "lunes, 2 de enero de 2006"))
+	assert.Equal("domingo, 23 de agosto de 2020", f.FormatIdioma("pt-BR", "lunes, 2 de enero de 2006"))
+	assert.Equal("Sunday, August 23, 2020", f.FormatIdioma("en", "lunes, enero 2, 2006"))
+	assert.Equal("SUN AUG 23", f.FormatIdioma("en", "LUN ENE 2"))
+	assert.Equal("Segunda-feira", Fecha(20200824).FormatIdioma("pt-BR", "Lunes"))
+	assert.Equal("Março/20", NewMesMust(2020, 3).FormatIdioma("pt-BR", "Enero/06"))
+
+	// Idioma desconocido usa el idioma por defecto
+	assert.Equal("agosto", f.FormatIdioma("de", "enero"))
+
+	assert.Equal("Domingo", f.DiaDeLaSemanaIdioma("es"))
+	assert.Equal("Sunday", f.DiaDeLaSemanaIdioma("en"))
+	assert.Equal("Terça-feira", Fecha(20200825).DiaDeLaSemanaIdioma("pt-BR"))
+}
+
+func TestNewFechaFromTextoIdioma(t *testing.T) {
+	assert := assert.New(t)
+
+	validos := []struct {
+		idioma string
+		texto  string
+	}{
+		{"pt-BR", "23 de agosto de 2020"},
+		{"pt-BR", "domingo, 23 de agosto de 2020"},
+		{"pt-BR", "segunda-feira, 23 ago 2020"},
+		{"en", "August 23, 2020"},
+		{"en", "Sunday, August 23rd, 2020"},
+		{"en", "the 23rd of August 2020"},
+		{"en", "23-Aug-2020"},
+		{"en", "08/23/2020"},
+		{"en", "2020-08-23"},
+	}
+	for _, v := range validos {
+		f, err := NewFechaFromTextoIdioma(v.idioma, v.texto)
+		assert.Nil(err, v.texto)
+		assert.Equal(Fecha(20200823), f, v.texto)
+	}
+
+	f, err := NewFechaFromTextoIdioma("pt-BR", "1º de março de 2021")
+	assert.Nil(err)
+	assert.Equal(Fecha(20210301), f)
+
+	_, err = NewFechaFromTextoIdioma("de", "23 August 2020")
+	assert.NotNil(err)
+
+	{ // Las variantes regionales no heredan el orden de "en"
+		f, err := NewFechaFromTextoIdioma("en-GB", "23/08/2020")
+		assert.Nil(err)
+		assert.Equal(Fecha(20200823), f)
+
+		f, err = NewFechaFromTextoIdioma("en-US", "08/23/2020")
+		assert.Nil(err)
+		assert.Equal(Fecha(20200823), f)
+
+		_, err = NewFechaFromTextoIdioma("en-GB", "08/23/2020")
+		assert.NotNil(err)
+	}
+
+	m, err := NewMesFromTextoIdioma("en", "Sept 2021")
+	assert.Nil(err)
+	assert.Equal(Mes{2021, 9}, m)
+
+	m, err = NewMesFromTextoIdioma("pt-BR", "dez/2021")
+	assert.Nil(err)
+	assert.Equal(Mes{2021, 12}, m)
+}
+
+func TestRegistrarIdioma(t *testing.T) {
+	assert := assert.New(t)
+
+	it := Idioma{
+		Codigo: "it",
+		Meses: [12]string{
+			"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio",
+			"agosto", "settembre", "ottobre", "novembre", "dicembre",
+		},
+		MesesAbreviados: [12]string{
+			"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic",
+		},
+		Dias: [7]string{
+			"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato",
+		},
+		DiasAbreviados: [7]string{
+			"dom", "lun", "mar", "mer", "gio", "ven", "sab",
+		},
+	}
+	assert.Nil(RegistrarIdioma(it))
+
+	assert.Equal("domenica 23 agosto 2020", Fecha(20200823).FormatIdioma("it", "lunes 2 enero 2006"))
+
+	f, err := NewFechaFromTextoIdioma("it", "lunedì 24 agosto 2020")
+	assert.Nil(err)
+	assert.Equal(Fecha(20200824), f)
+
+	{ // Los alias siguen al idioma registrado
+		es := idiomaCastellano
+		es.Dias[0] = "doMINgo"
+		assert.Nil(RegistrarIdioma(es))
+		assert.Equal("DoMINgo", Fecha(20200823).DiaDeLaSemanaIdioma("es"))
+		assert.Equal("DoMINgo", Fecha(20200823).DiaDeLaSemana())
+
+		assert.Nil(RegistrarIdioma(idiomaCastellano))
+		assert.Equal("Domingo", Fecha(20200823).DiaDeLaSemanaIdioma("es"))
+	}
+	{ // Sin código
+		i := it
+		i.Codigo = ""
+		assert.NotNil(RegistrarIdioma(i))
+	}
+	{ // Falta un nombre
+		i := it
+		i.Codigo = "xx"
+		i.Meses[3] = ""
+		assert.NotNil(RegistrarIdioma(i))
+	}
+	{ // Alias inválido
+		i := it
+		i.Codigo = "xx"
+		i.AliasMeses = map[string]int{"foo": 13}
+		assert.NotNil(RegistrarIdioma(i))
+	}
+}
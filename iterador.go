@@ -0,0 +1,181 @@
+package fecha
+
+// IteradorFechas recorre las fechas entre dos días (ambos inclusive) sin
+// generar un slice, por lo que sirve para rangos de muchos años:
+//
+//	it := fecha.NewIteradorFechas(desde, hasta)
+//	for it.Siguiente() {
+//		f := it.Fecha()
+//		...
+//	}
+//
+// Si hasta es Infinito recorre hasta la última fecha válida (31/12/9999), por
+// lo que el ciclo debe cortarse por otra condición. Si hasta es anterior a
+// desde o alguna de las fechas no es válida, no devuelve ninguna fecha.
+type IteradorFechas struct {
+	actual  Fecha
+	proxima Fecha
+	hasta   Fecha
+
+	// Si no es nil, sólo se devuelven los días hábiles.
+	cal Calendario
+}
+
+// NewIteradorFechas devuelve un iterador de todos los días entre desde y hasta.
+func NewIteradorFechas(desde, hasta Fecha) *IteradorFechas {
+	return newIteradorFechas(desde, hasta, nil)
+}
+
+// NewIteradorDiasHabiles devuelve un iterador de los días hábiles entre desde
+// y hasta según el calendario. Si el calendario es nil se utiliza CalendarioPorDefecto.
+func NewIteradorDiasHabiles(desde, hasta Fecha, cal Calendario) *IteradorFechas {
+	return newIteradorFechas(desde, hasta, calendarioOPorDefecto(cal))
+}
+
+// Iterador devuelve un iterador de los días del rango. Si el rango no tiene
+// fin o termina en Infinito, recorre hasta el 31/12/9999; si no tiene inicio
+// o comienza en MenosInfinito, no devuelve ninguna fecha.
+func (r Rango) Iterador() *IteradorFechas {
+	if r.Vacio() {
+		return &IteradorFechas{}
+	}
+	hasta := r.Hasta
+	if r.SinFin() {
+		hasta = Infinito
+	}
+	return NewIteradorFechas(r.Desde, hasta)
+}
+
+// IteradorDias devuelve un iterador de los días del mes.
+func (m Mes) IteradorDias() *IteradorFechas {
+	if !m.Valid() {
+		return &IteradorFechas{}
+	}
+	return NewIteradorFechas(m.PrimerDia(), m.UltimoDia())
+}
+
+func newIteradorFechas(desde, hasta Fecha, cal Calendario) *IteradorFechas {
+	if hasta == Infinito {
+		hasta = 99991231
+	}
+	if !desde.IsValid() || !hasta.IsValid() {
+		return &IteradorFechas{}
+	}
+	return &IteradorFechas{
+		proxima: desde,
+		hasta:   hasta,
+		cal:     cal,
+	}
+}
+
+// Siguiente avanza a la próxima fecha. Devuelve false cuando no quedan más.
+func (it *IteradorFechas) Siguiente() bool {
+	if it.proxima == 0 {
+		return false
+	}
+	f := it.proxima
+	for it.cal != nil && f <= it.hasta && !it.cal.EsHabil(f) {
+		f = f.AgregarDias(1)
+	}
+	if f > it.hasta {
+		it.actual, it.proxima = 0, 0
+		return false
+	}
+	it.actual = f
+	it.proxima = f.AgregarDias(1)
+	return true
+}
+
+// Fecha devuelve la fecha actual. Antes de llamar a Siguiente, o después de
+// que devuelva false, es cero.
+func (it *IteradorFechas) Fecha() Fecha {
+	return it.actual
+}
+
+// IteradorMeses recorre los meses entre dos meses (ambos inclusive):
+//
+//	it := fecha.NewIteradorMeses(desde, hasta)
+//	for it.Siguiente() {
+//		m := it.Mes()
+//		...
+//	}
+//
+// Si hasta es anterior a desde o alguno de los meses no es válido, no
+// devuelve ningún mes.
+type IteradorMeses struct {
+	actual  Mes
+	proximo Mes
+	hasta   Mes
+}
+
+// NewIteradorMeses devuelve un iterador de todos los meses entre desde y hasta.
+func NewIteradorMeses(desde, hasta Mes) *IteradorMeses {
+	if !desde.Valid() || !hasta.Valid() {
+		return &IteradorMeses{}
+	}
+	return &IteradorMeses{
+		proximo: desde,
+		hasta:   hasta,
+	}
+}
+
+// Siguiente avanza al próximo mes. Devuelve false cuando no quedan más.
+func (it *IteradorMeses) Siguiente() bool {
+	if it.proximo.Zero() || it.proximo.Posterior(it.hasta) {
+		it.actual, it.proximo = Mes{}, Mes{}
+		return false
+	}
+	it.actual = it.proximo
+	it.proximo = it.proximo.SumarMeses(1)
+	return true
+}
+
+// Mes devuelve el mes actual. Antes de llamar a Siguiente, o después de
+// que devuelva false, es NilMes.
+func (it *IteradorMeses) Mes() Mes {
+	return it.actual
+}
+
+// IteradorSemanas recorre las semanas entre dos semanas (ambas inclusive):
+//
+//	it := fecha.NewIteradorSemanas(desde, hasta)
+//	for it.Siguiente() {
+//		s := it.Semana()
+//		...
+//	}
+//
+// Si hasta es anterior a desde o alguna de las semanas no es válida, no
+// devuelve ninguna semana.
+type IteradorSemanas struct {
+	actual  Semana
+	proxima Semana
+	hasta   Semana
+}
+
+// NewIteradorSemanas devuelve un iterador de todas las semanas entre desde y hasta.
+func NewIteradorSemanas(desde, hasta Semana) *IteradorSemanas {
+	if !desde.Valid() || !hasta.Valid() {
+		return &IteradorSemanas{}
+	}
+	return &IteradorSemanas{
+		proxima: desde,
+		hasta:   hasta,
+	}
+}
+
+// Siguiente avanza a la próxima semana. Devuelve false cuando no quedan más.
+func (it *IteradorSemanas) Siguiente() bool {
+	if it.proxima.Zero() || it.proxima.Posterior(it.hasta) {
+		it.actual, it.proxima = Semana{}, Semana{}
+		return false
+	}
+	it.actual = it.proxima
+	it.proxima = it.proxima.SumarSemanas(1)
+	return true
+}
+
+// Semana devuelve la semana actual. Antes de llamar a Siguiente, o después de
+// que devuelva false, es NilSemana.
+func (it *IteradorSemanas) Semana() Semana {
+	return it.actual
+}
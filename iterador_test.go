@@ -0,0 +1,150 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIteradorFechas(t *testing.T) {
+	assert := assert.New(t)
+
+	{
+		fechas := []Fecha{}
+		it := NewIteradorFechas(Fecha(20201229), Fecha(20210102))
+		assert.Equal(Fecha(0), it.Fecha())
+		for it.Siguiente() {
+			fechas = append(fechas, it.Fecha())
+		}
+		assert.Equal([]Fecha{20201229, 20201230, 20201231, 20210101, 20210102}, fechas)
+		assert.False(it.Siguiente())
+		assert.Equal(Fecha(0), it.Fecha())
+	}
+	{ // Un día
+		it := NewIteradorFechas(Fecha(20201229), Fecha(20201229))
+		assert.True(it.Siguiente())
+		assert.Equal(Fecha(20201229), it.Fecha())
+		assert.False(it.Siguiente())
+	}
+	{ // Invertido o inválido
+		assert.False(NewIteradorFechas(Fecha(20201230), Fecha(20201229)).Siguiente())
+		assert.False(NewIteradorFechas(Fecha(0), Fecha(20201229)).Siguiente())
+		assert.False(NewIteradorFechas(Fecha(20201229), Fecha(20201299)).Siguiente())
+	}
+	{ // Hasta Infinito recorre hasta la última fecha válida
+		it := NewIteradorFechas(Fecha(99991230), Infinito)
+		assert.True(it.Siguiente())
+		assert.True(it.Siguiente())
+		assert.Equal(Fecha(99991231), it.Fecha())
+		assert.False(it.Siguiente())
+		assert.False(NewIteradorFechas(MenosInfinito, Fecha(20201229)).Siguiente())
+	}
+	{ // Contra TimeSeries
+		desde, hasta := Fecha(20190101), Fecha(20211231)
+		esperado, err := TimeSeries(desde, hasta, AgrupacionDiaria)
+		assert.Nil(err)
+
+		fechas := []Fecha{}
+		for it := NewIteradorFechas(desde, hasta); it.Siguiente(); {
+			fechas = append(fechas, it.Fecha())
+		}
+		assert.Equal(esperado, fechas)
+	}
+}
+
+func TestIteradorDiasHabiles(t *testing.T) {
+	assert := assert.New(t)
+	cal := NewCalendarioFeriados(Feriado{Fecha(20200817), "San Martín"})
+
+	fechas := []Fecha{}
+	for it := NewIteradorDiasHabiles(Fecha(20200815), Fecha(20200823), cal); it.Siguiente(); {
+		fechas = append(fechas, it.Fecha())
+	}
+	assert.Equal([]Fecha{20200818, 20200819, 20200820, 20200821}, fechas)
+
+	// Sin días hábiles
+	assert.False(NewIteradorDiasHabiles(Fecha(20200822), Fecha(20200823), nil).Siguiente())
+
+	// Cantidad igual a DiasHabilesEntre
+	desde, hasta := Fecha(20200101), Fecha(20221231)
+	n := 0
+	for it := NewIteradorDiasHabiles(desde, hasta, cal); it.Siguiente(); {
+		n++
+	}
+	assert.Equal(DiasHabilesEntre(desde, hasta.AgregarDias(1), cal), n)
+}
+
+func TestIteradorMes(t *testing.T) {
+	assert := assert.New(t)
+
+	{
+		it := NewMesMust(2020, 2).IteradorDias()
+		n := 0
+		for it.Siguiente() {
+			n++
+			assert.Equal(n, it.Fecha().Dia())
+		}
+		assert.Equal(29, n)
+	}
+	assert.False(Mes{}.IteradorDias().Siguiente())
+
+	{
+		fechas := []Fecha{}
+		for it := (Rango{20200830, 20200902}).Iterador(); it.Siguiente(); {
+			fechas = append(fechas, it.Fecha())
+		}
+		assert.Equal([]Fecha{20200830, 20200831, 20200901, 20200902}, fechas)
+	}
+	for _, r := range []Rango{{20201230, Infinito}, {20201230, sinFin}} {
+		fechas := []Fecha{}
+		for it := r.Iterador(); it.Siguiente() && len(fechas) < 3; {
+			fechas = append(fechas, it.Fecha())
+		}
+		assert.Equal([]Fecha{20201230, 20201231, 20210101}, fechas, "%v", r)
+	}
+	assert.False(Rango{sinInicio, 20201230}.Iterador().Siguiente())
+	assert.False(RangoVacio().Iterador().Siguiente())
+}
+
+func TestIteradorMeses(t *testing.T) {
+	assert := assert.New(t)
+
+	meses := []Mes{}
+	it := NewIteradorMeses(NewMesMust(2020, 11), NewMesMust(2021, 2))
+	assert.Equal(NilMes, it.Mes())
+	for it.Siguiente() {
+		meses = append(meses, it.Mes())
+	}
+	assert.Equal([]Mes{{2020, 11}, {2020, 12}, {2021, 1}, {2021, 2}}, meses)
+	assert.False(it.Siguiente())
+	assert.Equal(NilMes, it.Mes())
+
+	assert.False(NewIteradorMeses(NewMesMust(2021, 2), NewMesMust(2020, 11)).Siguiente())
+	assert.False(NewIteradorMeses(NilMes, NewMesMust(2020, 11)).Siguiente())
+}
+
+func TestIteradorSemanas(t *testing.T) {
+	assert := assert.New(t)
+
+	// 2020 tiene 53 semanas
+	semanas := []Semana{}
+	it := NewIteradorSemanas(NewSemanaMust(2020, 52), NewSemanaMust(2021, 2))
+	assert.Equal(NilSemana, it.Semana())
+	for it.Siguiente() {
+		semanas = append(semanas, it.Semana())
+	}
+	assert.Equal([]Semana{{2020, 52}, {2020, 53}, {2021, 1}, {2021, 2}}, semanas)
+	assert.False(it.Siguiente())
+	assert.Equal(NilSemana, it.Semana())
+
+	assert.False(NewIteradorSemanas(NewSemanaMust(2021, 2), NewSemanaMust(2020, 52)).Siguiente())
+	assert.False(NewIteradorSemanas(NilSemana, NewSemanaMust(2020, 52)).Siguiente())
+	assert.False(NewIteradorSemanas(NewSemanaMust(2020, 52), Semana{2021, 53}).Siguiente())
+}
+
+func BenchmarkIteradorFechas(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for it := NewIteradorFechas(Fecha(20000101), Fecha(20301231)); it.Siguiente(); {
+		}
+	}
+}
@@ -2,7 +2,10 @@ package fecha
 
 import (
 	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -38,6 +41,27 @@ type Mes struct {
 
 var NilMes = Mes{}
 
+var (
+	mesInfinito      = Mes{año: math.MaxInt32}
+	mesMenosInfinito = Mes{año: math.MinInt32}
+)
+
+// MesInfinito y MesMenosInfinito devuelven los meses de las fechas Infinito y
+// MenosInfinito. Son posterior y anterior a cualquier otro mes. En JSON y
+// texto se escriben "infinity" y "-infinity", y en la base de datos se
+// persisten como 'infinity' y '-infinity'.
+//
+// No son meses válidos (Valid devuelve false): SumarMeses los devuelve sin
+// cambios y PrimerDia y UltimoDia devuelven Infinito o MenosInfinito.
+func MesInfinito() Mes {
+	return mesInfinito
+}
+
+// MesMenosInfinito devuelve el mes de la fecha MenosInfinito. Ver MesInfinito.
+func MesMenosInfinito() Mes {
+	return mesMenosInfinito
+}
+
 func NewMesMust(año, mes int) Mes {
 	m := Mes{año, mes}
 	if m.mes < 1 || m.mes > 12 {
@@ -60,7 +84,15 @@ func NewMes(año, mes int) (Mes, error) {
 	return m, nil
 }
 
+// NewMesFromJSON parsea un mes con formato "2020-08".
+// Acepta también "infinity" y "-infinity".
 func NewMesFromJSON(str string) (out Mes, err error) {
+	switch str {
+	case "infinity":
+		return mesInfinito, nil
+	case "-infinity":
+		return mesMenosInfinito, nil
+	}
 	if len(str) != 7 {
 		return out, fmt.Errorf("incorrect format: expected YYYY-MM; got: %v", str)
 	}
@@ -125,6 +157,12 @@ func (m Mes) PosteriorOIgual(f2 Mes) bool {
 }
 
 func (m Mes) String() (out string) {
+	switch m {
+	case mesInfinito:
+		return "infinito"
+	case mesMenosInfinito:
+		return "-infinito"
+	}
 	if !m.Valid() {
 		return "mes inválido"
 	}
@@ -172,6 +210,11 @@ func (m Mes) Valid() bool {
 	return true
 }
 
+// IsInfinite devuelve true si es MesInfinito o MesMenosInfinito.
+func (m Mes) IsInfinite() bool {
+	return m == mesInfinito || m == mesMenosInfinito
+}
+
 // Zero devuelve true si el día y el año son cero
 func (m Mes) Zero() bool {
 	if m.año == 0 && m.mes == 0 {
@@ -183,7 +226,11 @@ func (m Mes) Zero() bool {
 // SumarMeses devuelve una nueva fecha con los meses agregados.
 // Si se quiere restar, ingresar meses en negativo.
 // Se supone que se está trabajando con un Mes válido no cero.
+// MesInfinito y MesMenosInfinito no cambian.
 func (m Mes) SumarMeses(meses int) (out Mes) {
+	if m.IsInfinite() {
+		return m
+	}
 	out.año = m.año
 	out.mes = m.mes + meses
 
@@ -211,18 +258,42 @@ func (m Mes) SumarMeses(meses int) (out Mes) {
 
 // PrimerDia devuelve la fecha considerando el primer día del período.
 func (m Mes) PrimerDia() Fecha {
+	if f, ok := m.fechaInfinita(); ok {
+		return f
+	}
 	return NewFechaFromInts(m.año, m.mes, 1)
 }
 
 // UltimoDia devuelve la fecha considerando el último día del período.
 func (m Mes) UltimoDia() Fecha {
+	if f, ok := m.fechaInfinita(); ok {
+		return f
+	}
 	return NewFechaFromInts(m.año, m.mes, ultimoDia(m.mes, m.año))
 }
 
+// Devuelve Infinito o MenosInfinito si el mes es infinito.
+func (m Mes) fechaInfinita() (Fecha, bool) {
+	switch m {
+	case mesInfinito:
+		return Infinito, true
+	case mesMenosInfinito:
+		return MenosInfinito, true
+	}
+	return 0, false
+}
+
 // JSONString devuelve la representación que se utiliza en JSON.
 // Si es cero devuelve null
 // Si no es válida devuelve "N/D"
+// MesInfinito y MesMenosInfinito devuelven "infinity" y "-infinity".
 func (m Mes) JSONString() string {
+	switch m {
+	case mesInfinito:
+		return "infinity"
+	case mesMenosInfinito:
+		return "-infinity"
+	}
 
 	if !m.Valid() {
 		return "null"
@@ -262,6 +333,9 @@ func (m Mes) Value() (driver.Value, error) {
 	if m.Zero() {
 		return nil, nil
 	}
+	if m.IsInfinite() {
+		return m.JSONString(), nil
+	}
 	if !m.Valid() {
 		return m, fmt.Errorf("invalid month '%v' (must be between 1 and 12)", m.mes)
 	}
@@ -269,22 +343,27 @@ func (m Mes) Value() (driver.Value, error) {
 }
 
 // Scan satisface la interface de package sql.
-// Si el valor que estaba persistido es menor al mínimo o mayor al máximo,
+// Acepta columnas DATE (se toma el mes de la fecha), de texto ("2020-08" o
+// "2020-08-01") y enteras (202008). NULL se lee como NilMes.
+// Si una fecha persistida es menor al mínimo o mayor al máximo,
 // no va a dar a error. Queda a criterio del usuario analizarla con
 // el método Valid().
-// Si el va
 func (m *Mes) Scan(value interface{}) error {
-	if value == nil {
+	switch v := value.(type) {
+	case nil:
 		*m = Mes{}
-	}
-
-	enTime, ok := value.(time.Time)
-	if !ok {
-		return fmt.Errorf("expected value type: time.Time, got: %T", value)
-	}
-	*m = NewFechaFromTime(enTime).PeriodoMes()
-
-	return nil
+		return nil
+	case time.Time:
+		*m = NewFechaFromTime(v).PeriodoMes()
+		return nil
+	case int64:
+		return m.asignar(mesDesdeEntero(int(v)))
+	case string:
+		return m.asignar(mesDesdeTexto(v))
+	case []byte:
+		return m.asignar(mesDesdeTexto(string(v)))
+	}
+	return fmt.Errorf("expected value type: time.Time, int64, string or []byte, got: %T", value)
 }
 
 // MarshalJSON es para tomar una una struct a un string JSON.
@@ -294,7 +373,7 @@ func (m Mes) MarshalJSON() (by []byte, err error) {
 		by = []byte("null")
 		return by, nil
 	}
-	if !m.Valid() {
+	if !m.Valid() && !m.IsInfinite() {
 		return by, fmt.Errorf("no se puede marshalizar la fecha %v, (no es válida)", m)
 	}
 	by = []byte(`"` + m.JSONString() + `"`)
@@ -327,6 +406,116 @@ func (m *Mes) UnmarshalJSON(input []byte) (err error) {
 	return
 }
 
+var _ encoding.TextMarshaler = Mes{}
+var _ encoding.TextUnmarshaler = (*Mes)(nil)
+var _ encoding.BinaryMarshaler = Mes{}
+var _ encoding.BinaryUnmarshaler = (*Mes)(nil)
+
+// MarshalText devuelve el mes con formato 2020-08.
+// Permite usar Mes como clave de un map en JSON, en atributos XML o con flag.TextVar.
+// Si es cero devuelve un texto vacío.
+func (m Mes) MarshalText() ([]byte, error) {
+	if m.Zero() {
+		return []byte{}, nil
+	}
+	if !m.Valid() && !m.IsInfinite() {
+		return nil, fmt.Errorf("invalid month '%v-%v'", m.año, m.mes)
+	}
+	return []byte(m.JSONString()), nil
+}
+
+// UnmarshalText parsea un mes con formato 2020-08.
+// Si el texto está vacío, el mes queda en cero.
+func (m *Mes) UnmarshalText(input []byte) error {
+	if len(input) == 0 {
+		*m = Mes{}
+		return nil
+	}
+	nuevo, err := NewMesFromJSON(string(input))
+	if err != nil {
+		return err
+	}
+	*m = nuevo
+	return nil
+}
+
+// MarshalBinary devuelve el mes como un entero de 4 bytes big-endian
+// (202008). El mes cero se codifica como 0 y MesInfinito y MesMenosInfinito
+// como el máximo y el mínimo int32.
+func (m Mes) MarshalBinary() ([]byte, error) {
+	n := int32(m.año*100 + m.mes)
+	switch {
+	case m == mesInfinito:
+		n = math.MaxInt32
+	case m == mesMenosInfinito:
+		n = math.MinInt32
+	case !m.Zero() && !m.Valid():
+		return nil, fmt.Errorf("invalid month '%v-%v'", m.año, m.mes)
+	}
+	return binary.BigEndian.AppendUint32(make([]byte, 0, 4), uint32(n)), nil
+}
+
+// UnmarshalBinary lee un mes codificado con MarshalBinary.
+func (m *Mes) UnmarshalBinary(input []byte) error {
+	if len(input) != 4 {
+		return fmt.Errorf("invalid binary month: expected 4 bytes, got %v", len(input))
+	}
+	n := int(int32(binary.BigEndian.Uint32(input)))
+	switch n {
+	case 0:
+		*m = Mes{}
+		return nil
+	case math.MaxInt32:
+		*m = mesInfinito
+		return nil
+	case math.MinInt32:
+		*m = mesMenosInfinito
+		return nil
+	}
+	nuevo, err := NewMes(n/100, n%100)
+	if err != nil {
+		return err
+	}
+	*m = nuevo
+	return nil
+}
+
+// Asigna el mes sólo si no hubo error.
+func (m *Mes) asignar(nuevo Mes, err error) error {
+	if err != nil {
+		return err
+	}
+	*m = nuevo
+	return nil
+}
+
+// Convierte un entero con formato 202008.
+func mesDesdeEntero(n int) (Mes, error) {
+	if n < 0 {
+		return Mes{}, fmt.Errorf("invalid month '%v' (expected YYYYMM)", n)
+	}
+	return NewMes(n/100, n%100)
+}
+
+// Convierte un texto con formato "2020-08", "2020-08-01" o "202008".
+func mesDesdeTexto(texto string) (Mes, error) {
+	switch {
+	case len(texto) == 10:
+		f, err := NewFecha(texto)
+		if err != nil {
+			return Mes{}, err
+		}
+		return f.PeriodoMes(), nil
+	case len(texto) == 6 && strings.Trim(texto, "0123456789") == "":
+		n, err := strconv.Atoi(texto)
+		if err != nil {
+			return Mes{}, err
+		}
+		return mesDesdeEntero(n)
+	}
+	return NewMesFromJSON(texto)
+}
+
 func ultimoDia(mes int, año int) (out int) {
 	out = 31
 	switch mes {
@@ -335,11 +524,10 @@ func ultimoDia(mes int, año int) (out int) {
 	}
 
 	if mes == 2 {
-		if año%4 == 0 && año%100 != 0 || año%400 == 0 {
+		if esBisiesto(año) {
 			return 29
-		} else {
-			return 28
 		}
+		return 28
 	}
 
 	return
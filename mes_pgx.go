@@ -7,50 +7,80 @@ import (
 	"github.com/jackc/pgtype"
 )
 
-var _ pgtype.ValueTranscoder = (*Fecha)(nil)
-var _ pgtype.Value = (*Fecha)(nil)
-var _ pgtype.TypeValue = (*Fecha)(nil)
+var _ pgtype.ValueTranscoder = (*Mes)(nil)
+var _ pgtype.Value = (*Mes)(nil)
+var _ pgtype.TypeValue = (*Mes)(nil)
 
-func (t *Mes) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
-	r := &pgtype.Date{}
+var _ pgtype.ParamFormatPreferrer = Mes{}
 
-	err := r.DecodeBinary(ci, src)
+// DecodeBinary lee el mes de una columna date. NULL se lee como NilMes e
+// 'infinity' y '-infinity' como MesInfinito y MesMenosInfinito.
+//
+// pgx no informa el tipo de la columna, así que los bytes siempre se leen como
+// date. Las columnas integer (202008) no se aceptan: sus bytes leídos como date
+// caen después de AñoMaximo y se devuelve error. Para columnas integer o text
+// ("2020-08") hay que leer un int o un string y pasarlo a Set, o usar el
+// formato texto.
+func (t *Mes) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*t = Mes{}
+		return nil
+	}
+	if len(src) != 4 {
+		return fmt.Errorf("invalid length for date: %v (integer and text columns must be read with Set)", len(src))
+	}
+	f, err := decodificarDate(ci, src, true)
 	if err != nil {
 		return err
 	}
-
-	switch r.Status {
-	case pgtype.Present:
-		if r.InfinityModifier != pgtype.None {
-			return fmt.Errorf("cannot assign %v", src)
-		}
-		*t = Mes{año: r.Time.Year(), mes: int(r.Time.Month())}
-		return nil
-	case pgtype.Null:
-		return pgtype.NullAssignTo(t)
+	m, err := mesDesdeFecha(f)
+	if err != nil {
+		return fmt.Errorf("%w (integer columns must be read with Set)", err)
 	}
-
-	return fmt.Errorf("cannot decode %#v", src)
-	// type compatibility is checked by AssignTo
-	// only lossless assignments will succeed
-	// if err := r.AssignTo(&t); err != nil {
-	// 	return err
-	// }
-
-	// return nil
+	*t = m
+	return nil
 }
 
+// EncodeBinary guarda el primer día del mes como DATE. NilMes se guarda como NULL.
+//
+// Como pgx no informa el tipo del parámetro, EncodeBinary sólo sirve para
+// columnas date. Por eso Mes prefiere el formato texto para los parámetros
+// (ver PreferredParamFormat).
 func (src Mes) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := src.date()
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeBinary(ci, buf)
+}
 
-	d := pgtype.Date{}
+// PreferredParamFormat hace que pgx envíe los parámetros Mes como texto
+// ("2020-08-01"). Una columna date lo toma como el primer día del mes y una
+// integer lo rechaza, en vez de guardar los días desde 2000-01-01.
+// Para columnas integer o text hay que pasar el entero (202008) o
+// m.JSONString().
+func (Mes) PreferredParamFormat() int16 {
+	return pgtype.TextFormatCode
+}
 
-	if src == NilMes {
-		d.Status = pgtype.Null
-	} else {
-		d.Status = pgtype.Present
-		d.Time = time.Date(src.año, time.Month(src.mes), 1, 0, 0, 0, 0, time.UTC)
+// DecodeText lee el mes de una columna date ("2020-08-01"), text ("2020-08")
+// o integer ("202008"). NULL se lee como NilMes.
+func (t *Mes) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*t = Mes{}
+		return nil
 	}
-	return d.EncodeBinary(ci, buf)
+	return t.asignar(mesDesdeTexto(string(src)))
+}
+
+// EncodeText guarda el primer día del mes como texto "2020-08-01".
+// NilMes se guarda como NULL.
+func (src Mes) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := src.date()
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeText(ci, buf)
 }
 
 // TypeName returns the PostgreSQL name of this type.
@@ -59,15 +89,143 @@ func (Mes) TypeName() string {
 }
 
 func (t *Mes) NewTypeValue() pgtype.Value {
-	return t
+	return new(Mes)
 }
 
+// Set acepta un Mes, una Fecha, un time.Time, un string ("2020-08" o
+// "2020-08-01"), un entero (202008) o un pgtype.Date (y punteros a ellos).
+// nil se toma como NilMes.
 func (t *Mes) Set(src interface{}) error {
-	return fmt.Errorf("cannot convert %v to Point", src)
+	switch v := src.(type) {
+	case nil:
+		*t = Mes{}
+	case Mes:
+		*t = v
+	case *Mes:
+		*t = Mes{}
+		if v != nil {
+			*t = *v
+		}
+	case Fecha:
+		return t.asignar(mesDesdeFecha(v))
+	case time.Time:
+		*t = NewFechaFromTime(v).PeriodoMes()
+	case *time.Time:
+		*t = Mes{}
+		if v != nil {
+			*t = NewFechaFromTime(*v).PeriodoMes()
+		}
+	case string:
+		return t.asignar(mesDesdeTexto(v))
+	case *string:
+		*t = Mes{}
+		if v != nil {
+			return t.asignar(mesDesdeTexto(*v))
+		}
+	case int:
+		return t.asignar(mesDesdeEntero(v))
+	case int32:
+		return t.asignar(mesDesdeEntero(int(v)))
+	case int64:
+		return t.asignar(mesDesdeEntero(int(v)))
+	case pgtype.Date:
+		f, err := fechaDesdeDate(v)
+		if err != nil {
+			return err
+		}
+		return t.asignar(mesDesdeFecha(f))
+	default:
+		return fmt.Errorf("cannot convert %v to Mes", src)
+	}
+	return nil
 }
+
+// Get devuelve el mes, o nil si es NilMes.
 func (t *Mes) Get() interface{} {
+	if t.Zero() {
+		return nil
+	}
 	return *t
 }
+
+// AssignTo asigna el mes a *Mes, *Fecha (primer día), *time.Time (primer día),
+// *string ("2020-08"), *int, *int32, *int64 (202008), *pgtype.Date o punteros
+// a punteros de ellos. Si es NilMes, los punteros a punteros quedan en nil y
+// los demás tipos, salvo *Mes, *Fecha y *pgtype.Date, devuelven error.
+// MesInfinito y MesMenosInfinito sólo se pueden asignar a *Mes, *Fecha,
+// *pgtype.Date y *string ("infinity" y "-infinity").
 func (t *Mes) AssignTo(dst interface{}) error {
+	switch v := dst.(type) {
+	case *Mes:
+		*v = *t
+		return nil
+	case *Fecha:
+		*v = 0
+		if !t.Zero() {
+			*v = t.PrimerDia()
+		}
+		return nil
+	case *pgtype.Date:
+		d, err := t.date()
+		if err != nil {
+			return err
+		}
+		*v = d
+		return nil
+	}
+
+	if t.Zero() {
+		return pgtype.NullAssignTo(dst)
+	}
+	if v, ok := dst.(*string); ok && t.IsInfinite() {
+		*v = t.JSONString()
+		return nil
+	}
+	if !t.Valid() {
+		return fmt.Errorf("cannot assign invalid month '%v-%v' to %T", t.año, t.mes, dst)
+	}
+
+	entero := t.año*100 + t.mes
+	switch v := dst.(type) {
+	case *time.Time:
+		*v = t.PrimerDia().Time()
+	case *string:
+		*v = t.JSONString()
+	case *int:
+		*v = entero
+	case *int32:
+		*v = int32(entero)
+	case *int64:
+		*v = int64(entero)
+	default:
+		if siguiente, ok := pgtype.GetAssignToDstType(dst); ok {
+			return t.AssignTo(siguiente)
+		}
+		return fmt.Errorf("unable to assign to %T", dst)
+	}
 	return nil
 }
+
+// Devuelve el primer día del mes como pgtype.Date. NilMes es NULL y
+// MesInfinito y MesMenosInfinito son 'infinity' y '-infinity'.
+func (m Mes) date() (pgtype.Date, error) {
+	if m.IsInfinite() {
+		return m.PrimerDia().date()
+	}
+	return datePeriodo(m)
+}
+
+// Devuelve el mes de la fecha. Cero es NilMes, Infinito y MenosInfinito son
+// MesInfinito y MesMenosInfinito y las fechas inválidas o fuera del rango de
+// Mes devuelven error.
+func mesDesdeFecha(f Fecha) (Mes, error) {
+	switch {
+	case f == 0:
+		return Mes{}, nil
+	case f.IsInfinite():
+		return f.PeriodoMes(), nil
+	case !f.IsValid():
+		return Mes{}, fmt.Errorf("invalid date '%v'", int(f))
+	}
+	return NewMes(f.Año(), f.Mes())
+}
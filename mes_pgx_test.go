@@ -0,0 +1,261 @@
+package fecha
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMesPgxBinario(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	{ // date
+		by, err := Mes{2020, 8}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+
+		var m Mes
+		assert.Nil(m.DecodeBinary(ci, by))
+		assert.Equal(Mes{2020, 8}, m)
+
+		by, err = pgtype.Date{Time: time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		assert.Nil(m.DecodeBinary(ci, by))
+		assert.Equal(Mes{2020, 8}, m)
+
+		by, err = pgtype.Date{Time: time.Date(AñoMinimo, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		assert.Nil(m.DecodeBinary(ci, by))
+		assert.Equal(Mes{AñoMinimo, 1}, m)
+	}
+	{ // Un integer se rechaza, nunca se lee como date
+		m := Mes{2020, 8}
+		assert.NotNil(m.DecodeBinary(ci, binary.BigEndian.AppendUint32(nil, 202008)))
+		assert.NotNil(m.DecodeBinary(ci, binary.BigEndian.AppendUint32(nil, 100001)))
+		assert.Equal(Mes{2020, 8}, m)
+	}
+	{ // Fecha fuera de rango
+		d := pgtype.Date{Time: time.Date(500, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}
+		by, err := d.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		m := Mes{2020, 8}
+		assert.NotNil(m.DecodeBinary(ci, by))
+		assert.NotNil(m.Set(d))
+		assert.NotNil(m.Set(Fecha(5000101)))
+		assert.Equal(Mes{2020, 8}, m)
+	}
+	{ // Los parámetros se envían como texto
+		assert.Equal(int16(pgtype.TextFormatCode), Mes{2020, 8}.PreferredParamFormat())
+	}
+	{ // bigint y text no son date
+		m := Mes{2020, 8}
+		assert.NotNil(m.DecodeBinary(ci, binary.BigEndian.AppendUint64(nil, 219912)))
+		assert.NotNil(m.DecodeBinary(ci, []byte("2020-08")))
+		assert.Equal(Mes{2020, 8}, m)
+	}
+	{ // Para integer y text se lee el valor y se usa Set
+		var m Mes
+		assert.Nil(m.Set(int32(202008)))
+		assert.Equal(Mes{2020, 8}, m)
+		assert.Nil(m.Set(int64(219912)))
+		assert.Equal(Mes{2199, 12}, m)
+		assert.Nil(m.Set("2020-08"))
+		assert.Equal(Mes{2020, 8}, m)
+	}
+	{ // NULL
+		by, err := NilMes.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		assert.Nil(by)
+
+		m := Mes{2020, 8}
+		assert.Nil(m.DecodeBinary(ci, nil))
+		assert.Equal(NilMes, m)
+	}
+	_, err := Mes{2020, 13}.EncodeBinary(ci, nil)
+	assert.NotNil(err)
+}
+
+func TestMesPgxTexto(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	by, err := Mes{2020, 8}.EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Equal("2020-08-01", string(by))
+
+	for _, v := range []string{"2020-08-01", "2020-08-23", "2020-08", "202008"} {
+		var m Mes
+		assert.Nil(m.DecodeText(ci, []byte(v)), v)
+		assert.Equal(Mes{2020, 8}, m, v)
+	}
+
+	m := Mes{2020, 8}
+	assert.Nil(m.DecodeText(ci, nil))
+	assert.Equal(NilMes, m)
+
+	by, err = NilMes.EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Nil(by)
+
+	for _, v := range []string{"2020-13", "202013", "20-08"} {
+		assert.NotNil(m.DecodeText(ci, []byte(v)), v)
+	}
+}
+
+func TestMesPgxInfinito(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	for _, v := range []Mes{MesInfinito(), MesMenosInfinito()} {
+		by, err := v.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		var m Mes
+		assert.Nil(m.DecodeBinary(ci, by))
+		assert.Equal(v, m)
+
+		by, err = v.EncodeText(ci, nil)
+		assert.Nil(err)
+		assert.Equal(v.JSONString(), string(by))
+		m = NilMes
+		assert.Nil(m.DecodeText(ci, by))
+		assert.Equal(v, m)
+	}
+
+	var m Mes
+	assert.Nil(m.Set(pgtype.Date{Status: pgtype.Present, InfinityModifier: pgtype.Infinity}))
+	assert.Equal(MesInfinito(), m)
+	assert.Nil(m.Scan("-infinity"))
+	assert.Equal(MesMenosInfinito(), m)
+
+	var f Fecha
+	assert.Nil(m.AssignTo(&f))
+	assert.Equal(MenosInfinito, f)
+	var texto string
+	assert.Nil(m.AssignTo(&texto))
+	assert.Equal("-infinity", texto)
+	assert.NotNil(m.AssignTo(new(int)))
+}
+
+func TestMesPgxSet(t *testing.T) {
+	assert := assert.New(t)
+
+	tm := time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC)
+	texto := "2020-08"
+	otro := Mes{2020, 8}
+	for _, v := range []interface{}{
+		Mes{2020, 8},
+		&otro,
+		Fecha(20200823),
+		tm,
+		&tm,
+		texto,
+		&texto,
+		"2020-08-23",
+		202008,
+		int32(202008),
+		int64(202008),
+		pgtype.Date{Time: tm, Status: pgtype.Present},
+	} {
+		var m Mes
+		assert.Nil(m.Set(v), "%T", v)
+		assert.Equal(Mes{2020, 8}, m, "%T", v)
+	}
+
+	for _, v := range []interface{}{nil, (*Mes)(nil), Fecha(0), (*time.Time)(nil), (*string)(nil), pgtype.Date{Status: pgtype.Null}} {
+		m := Mes{2020, 8}
+		assert.Nil(m.Set(v), "%T", v)
+		assert.Equal(NilMes, m, "%T", v)
+	}
+
+	m := Mes{2020, 8}
+	assert.NotNil(m.Set(3.5))
+	assert.NotNil(m.Set(202013))
+	assert.NotNil(m.Set("ago-20"))
+	assert.Equal(Mes{2020, 8}, m)
+
+	assert.Equal(Mes{2020, 8}, m.Get())
+	assert.Nil(new(Mes).Get())
+}
+
+func TestMesPgxAssignTo(t *testing.T) {
+	assert := assert.New(t)
+	m := Mes{2020, 8}
+
+	{
+		var dst Mes
+		assert.Nil(m.AssignTo(&dst))
+		assert.Equal(m, dst)
+	}
+	{
+		var dst Fecha
+		assert.Nil(m.AssignTo(&dst))
+		assert.Equal(Fecha(20200801), dst)
+	}
+	{
+		var dst time.Time
+		assert.Nil(m.AssignTo(&dst))
+		assert.Equal(time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC), dst)
+	}
+	{
+		var dst string
+		assert.Nil(m.AssignTo(&dst))
+		assert.Equal("2020-08", dst)
+	}
+	{
+		var i int
+		var i32 int32
+		var i64 int64
+		assert.Nil(m.AssignTo(&i))
+		assert.Nil(m.AssignTo(&i32))
+		assert.Nil(m.AssignTo(&i64))
+		assert.Equal(202008, i)
+		assert.Equal(int32(202008), i32)
+		assert.Equal(int64(202008), i64)
+	}
+	{
+		var dst pgtype.Date
+		assert.Nil(m.AssignTo(&dst))
+		assert.Equal(pgtype.Present, dst.Status)
+	}
+	{ // Puntero a puntero
+		var dst *string
+		assert.Nil(m.AssignTo(&dst))
+		assert.Equal("2020-08", *dst)
+	}
+	{ // NULL
+		dst := new(string)
+		assert.Nil(NilMes.AssignTo(&dst))
+		assert.Nil(dst)
+
+		f := Fecha(20200101)
+		assert.Nil(NilMes.AssignTo(&f))
+		assert.Equal(Fecha(0), f)
+
+		assert.NotNil(NilMes.AssignTo(new(int)))
+	}
+	assert.NotNil(m.AssignTo(new(float64)))
+}
+
+func TestScanMes(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, v := range []interface{}{
+		time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC),
+		int64(202008),
+		"2020-08",
+		[]byte("2020-08-01"),
+	} {
+		var m Mes
+		assert.Nil(m.Scan(v), "%T", v)
+		assert.Equal(Mes{2020, 8}, m, "%T", v)
+	}
+
+	m := Mes{2020, 8}
+	assert.Nil(m.Scan(nil))
+	assert.Equal(NilMes, m)
+	assert.NotNil(m.Scan(3.5))
+	assert.NotNil(m.Scan("2020-13"))
+}
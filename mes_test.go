@@ -1,6 +1,7 @@
 package fecha
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -359,3 +360,98 @@ func TestUnmarshalMes(t *testing.T) {
 	}
 
 }
+
+func TestMarshalTextMes(t *testing.T) {
+	assert := assert.New(t)
+
+	by, err := Mes{2020, 8}.MarshalText()
+	assert.Nil(err)
+	assert.Equal("2020-08", string(by))
+
+	by, err = Mes{}.MarshalText()
+	assert.Nil(err)
+	assert.Equal("", string(by))
+
+	_, err = Mes{2020, 13}.MarshalText()
+	assert.NotNil(err)
+
+	m := Mes{2020, 1}
+	assert.Nil(m.UnmarshalText([]byte("2020-08")))
+	assert.Equal(Mes{2020, 8}, m)
+	assert.NotNil(m.UnmarshalText([]byte("2020-13")))
+	assert.Equal(Mes{2020, 8}, m)
+	assert.Nil(m.UnmarshalText([]byte("")))
+	assert.Equal(Mes{}, m)
+
+	// Clave de un map en JSON
+	meses := map[Mes]float64{{2020, 8}: 10.5}
+	by, err = json.Marshal(meses)
+	assert.Nil(err)
+	assert.Equal(`{"2020-08":10.5}`, string(by))
+
+	leido := map[Mes]float64{}
+	assert.Nil(json.Unmarshal(by, &leido))
+	assert.Equal(meses, leido)
+}
+
+func TestMarshalBinaryMes(t *testing.T) {
+	assert := assert.New(t)
+
+	by, err := Mes{2020, 8}.MarshalBinary()
+	assert.Nil(err)
+	assert.Equal([]byte{0x00, 0x03, 0x15, 0x18}, by)
+
+	for _, v := range []Mes{{}, {2020, 8}, {AñoMinimo, 1}, {AñoMaximo, 12}} {
+		by, err := v.MarshalBinary()
+		assert.Nil(err)
+		m := Mes{2000, 1}
+		assert.Nil(m.UnmarshalBinary(by))
+		assert.Equal(v, m)
+	}
+
+	_, err = Mes{2020, 0}.MarshalBinary()
+	assert.NotNil(err)
+
+	m := Mes{2020, 8}
+	assert.NotNil(m.UnmarshalBinary([]byte{0, 0}))
+	assert.NotNil(m.UnmarshalBinary([]byte{0, 0x03, 0x15, 0x1d})) // 202013
+	assert.Equal(Mes{2020, 8}, m)
+}
+
+func TestMesInfinito(t *testing.T) {
+	assert := assert.New(t)
+	m := Mes{2020, 8}
+
+	assert.True(m.Anterior(MesInfinito()))
+	assert.True(m.Posterior(MesMenosInfinito()))
+	assert.True(MesInfinito().IsInfinite())
+	assert.False(MesInfinito().Valid())
+	assert.False(MesInfinito().Zero())
+	assert.Equal(MesInfinito(), MesInfinito().SumarMeses(5))
+	assert.Equal(Infinito, MesInfinito().PrimerDia())
+	assert.Equal(MenosInfinito, MesMenosInfinito().UltimoDia())
+	assert.Equal("infinito", MesInfinito().String())
+	assert.Equal("-infinito", MesMenosInfinito().Format("enero 2006"))
+	assert.Equal(NilTrimestre, MesInfinito().PeriodoTrimestre())
+	assert.Equal(NilSemestre, MesMenosInfinito().PeriodoSemestre())
+	assert.Equal(NilAño, MesInfinito().PeriodoAño())
+
+	for texto, v := range map[string]Mes{"infinity": MesInfinito(), "-infinity": MesMenosInfinito()} {
+		by, err := json.Marshal(v)
+		assert.Nil(err)
+		assert.Equal(`"`+texto+`"`, string(by))
+		var leido Mes
+		assert.Nil(json.Unmarshal(by, &leido))
+		assert.Equal(v, leido)
+
+		by, err = v.MarshalBinary()
+		assert.Nil(err)
+		leido = Mes{}
+		assert.Nil(leido.UnmarshalBinary(by))
+		assert.Equal(v, leido)
+
+		valor, err := v.Value()
+		assert.Nil(err)
+		assert.Equal(texto, valor)
+	}
+}
@@ -0,0 +1,238 @@
+package fecha
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// NewFechaFromTexto parsea una fecha escrita en castellano, como las que
+// aparecen en extractos bancarios o documentos escaneados. Acepta, entre otros:
+//
+//	"23 de agosto de 2020"
+//	"domingo 23 de agosto de 2020"
+//	"23-ago-2020", "23 ago 20", "23/08/2020"
+//	"1° de enero", "1ro de enero de 2021"
+//
+// El día va siempre antes que el mes. Los años de dos dígitos se interpretan
+// igual que en time.Parse: 69 a 99 => 1969 a 1999; 00 a 68 => 2000 a 2068.
+// Si no se indica el año, se toma el año actual.
+func NewFechaFromTexto(texto string) (fch Fecha, err error) {
+	return NewFechaFromTextoIdioma(IdiomaPorDefecto, texto)
+}
+
+// NewFechaFromTextoIdioma es igual a NewFechaFromTexto pero reconoce los
+// nombres del idioma indicado, por ejemplo:
+//
+//	NewFechaFromTextoIdioma("pt-BR", "23 de agosto de 2020")
+//	NewFechaFromTextoIdioma("en", "August 23rd, 2020")
+//
+// En los idiomas con MesAntesQueDia, las fechas numéricas se interpretan como 08/23/2020.
+// Devuelve error si el idioma no está registrado.
+func NewFechaFromTextoIdioma(idioma, texto string) (fch Fecha, err error) {
+	i, ok := buscarIdioma(idioma)
+	if !ok {
+		return fch, fmt.Errorf("unknown language '%v'", idioma)
+	}
+	t, err := interpretarTexto(texto, true, i)
+	if err != nil {
+		return fch, fmt.Errorf("parsing string '%v': %w", texto, err)
+	}
+	if t.dia == 0 {
+		return fch, fmt.Errorf("parsing string '%v': missing day", texto)
+	}
+	if t.año == 0 {
+		t.año = time.Now().Year()
+	}
+	// fechaDesdeCivil normaliza los días fuera de rango, así que se validan
+	// antes para que "31 de febrero" no se lea como una fecha de marzo.
+	if t.mes < 1 || t.mes > 12 {
+		return fch, fmt.Errorf("parsing string '%v': invalid month %v", texto, t.mes)
+	}
+	if t.dia < 1 || t.dia > ultimoDia(t.mes, t.año) {
+		return fch, fmt.Errorf("parsing string '%v': invalid day %v", texto, t.dia)
+	}
+
+	fch = fechaDesdeCivil(t.año, t.mes, t.dia)
+	if !fch.IsValid() {
+		return 0, fmt.Errorf("parsing string '%v': invalid date", texto)
+	}
+	return fch, nil
+}
+
+// NewMesFromTexto parsea un mes escrito en castellano. Acepta, entre otros:
+//
+//	"agosto 2020", "agosto de 2020", "Ago/2020", "ago-20", "08/2020", "2020-08"
+func NewMesFromTexto(texto string) (m Mes, err error) {
+	return NewMesFromTextoIdioma(IdiomaPorDefecto, texto)
+}
+
+// NewMesFromTextoIdioma es igual a NewMesFromTexto pero reconoce los
+// nombres del idioma indicado. Devuelve error si el idioma no está registrado.
+func NewMesFromTextoIdioma(idioma, texto string) (m Mes, err error) {
+	i, ok := buscarIdioma(idioma)
+	if !ok {
+		return m, fmt.Errorf("unknown language '%v'", idioma)
+	}
+	t, err := interpretarTexto(texto, false, i)
+	if err != nil {
+		return m, fmt.Errorf("parsing string '%v': %w", texto, err)
+	}
+	if t.dia != 0 {
+		return m, fmt.Errorf("parsing string '%v': unexpected day", texto)
+	}
+	if t.año == 0 {
+		return m, fmt.Errorf("parsing string '%v': missing year", texto)
+	}
+	return NewMes(t.año, t.mes)
+}
+
+// Resultado de interpretar un texto. Los campos que no estaban quedan en cero.
+type textoInterpretado struct {
+	año, mes, dia int
+}
+
+// Quita las tildes y los indicadores de ordinal (1º), que unicode considera letras.
+var quitarTildes = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c",
+	"º", " ", "ª", " ",
+)
+
+// Devuelve la palabra en minúsculas y sin tildes.
+func normalizarPalabra(texto string) string {
+	return quitarTildes.Replace(strings.ToLower(texto))
+}
+
+// Separa el texto en palabras y números, en minúsculas y sin tildes.
+func separarTexto(texto string) (tokens []string) {
+	return strings.FieldsFunc(normalizarPalabra(texto), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Separa los números pegados a letras: "1ro" => "1", "ro".
+func separarNumeros(tokens []string) (out []string) {
+	for _, v := range tokens {
+		inicio := 0
+		for i := 1; i < len(v); i++ {
+			if esDigito(v[i]) != esDigito(v[i-1]) {
+				out = append(out, v[inicio:i])
+				inicio = i
+			}
+		}
+		out = append(out, v[inicio:])
+	}
+	return out
+}
+
+func esDigito(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// Si conDia es false, el texto representa un mes y un único número junto al
+// nombre del mes se interpreta como año ("ago-20" => agosto de 2020).
+func interpretarTexto(texto string, conDia bool, idioma *idiomaRegistrado) (t textoInterpretado, err error) {
+	numeros := []string{}
+	// Palabra que puede ser un mes o un día de la semana ("mar" es marzo o
+	// martes). Se decide al final según si hace falta el nombre del mes.
+	ambigua := 0
+	for _, v := range separarNumeros(separarTexto(texto)) {
+		if esDigito(v[0]) {
+			numeros = append(numeros, v)
+			continue
+		}
+		if mes, ok := idioma.meses[v]; ok && idioma.ignoradas[v] {
+			ambigua = mes
+			continue
+		}
+		if mes, ok := idioma.meses[v]; ok {
+			if t.mes != 0 {
+				return t, fmt.Errorf("more than one month")
+			}
+			t.mes = mes
+			continue
+		}
+		if idioma.ignoradas[v] {
+			continue
+		}
+		return t, fmt.Errorf("unexpected word '%v'", v)
+	}
+	// Sin nombre de mes, las fechas tienen tres números y los meses dos.
+	numerico := len(numeros) == 3 || !conDia && len(numeros) == 2
+	if t.mes == 0 && ambigua != 0 && !numerico {
+		t.mes = ambigua
+	}
+
+	switch {
+	case t.mes != 0 && len(numeros) == 1 && (!conDia || len(numeros[0]) == 4):
+		// agosto 2020
+		t.año, err = parsearAño(numeros[0])
+	case t.mes != 0 && len(numeros) == 1:
+		// 23 de agosto
+		t.dia, err = strconv.Atoi(numeros[0])
+	case t.mes != 0 && len(numeros) == 2:
+		// 23 de agosto de 2020 o August 23, 2020
+		t.dia, err = strconv.Atoi(numeros[0])
+		if err == nil {
+			t.año, err = parsearAño(numeros[1])
+		}
+	case t.mes == 0 && len(numeros) == 2 && !conDia:
+		// 08/2020 o 2020-08
+		if len(numeros[0]) == 4 {
+			numeros[0], numeros[1] = numeros[1], numeros[0]
+		}
+		t.mes, err = strconv.Atoi(numeros[0])
+		if err == nil {
+			t.año, err = parsearAño(numeros[1])
+		}
+	case t.mes == 0 && len(numeros) == 3:
+		// 23/08/2020, 08/23/2020 o 2020-08-23
+		switch {
+		case len(numeros[0]) == 4:
+			numeros[0], numeros[2] = numeros[2], numeros[0]
+		case idioma.MesAntesQueDia:
+			numeros[0], numeros[1] = numeros[1], numeros[0]
+		}
+		t.dia, err = strconv.Atoi(numeros[0])
+		if err == nil {
+			t.mes, err = strconv.Atoi(numeros[1])
+		}
+		if err == nil {
+			t.año, err = parsearAño(numeros[2])
+		}
+	default:
+		return t, fmt.Errorf("unrecognized date format")
+	}
+	if err != nil {
+		return t, err
+	}
+	if t.mes < 1 || t.mes > 12 {
+		return t, fmt.Errorf("invalid month '%v'", t.mes)
+	}
+	return t, nil
+}
+
+// Parsea un año de cuatro o dos dígitos.
+func parsearAño(texto string) (año int, err error) {
+	año, err = strconv.Atoi(texto)
+	if err != nil {
+		return año, err
+	}
+	switch len(texto) {
+	case 4:
+		return año, nil
+	case 2:
+		if año >= 69 {
+			return año + 1900, nil
+		}
+		return año + 2000, nil
+	}
+	return 0, fmt.Errorf("invalid year '%v'", texto)
+}
@@ -0,0 +1,96 @@
+package fecha
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFechaFromTexto(t *testing.T) {
+	assert := assert.New(t)
+
+	validos := map[string]Fecha{
+		"23 de agosto de 2020":           20200823,
+		"Domingo 23 de Agosto de 2020":   20200823,
+		"domingo, 23 de agosto del 2020": 20200823,
+		"23-ago-2020":                    20200823,
+		"23-AGO-20":                      20200823,
+		"23 ago. 2020":                   20200823,
+		"23/08/2020":                     20200823,
+		"2020-08-23":                     20200823,
+		"1° de enero de 2021":            20210101,
+		"1º de enero de 2021":            20210101,
+		"1ro de enero de 2021":           20210101,
+		"15 de setiembre de 1999":        19990915,
+		"15-sept-99":                     19990915,
+		"miércoles 2 de marzo de 2022":   20220302,
+		"2 de MARZO de 2022":             20220302,
+		"mar 1 de septiembre de 2020":    20200901,
+		"mar 01/09/2020":                 20200901,
+		"mar, 3 de mar de 2020":          20200303,
+		"3 mar 2020":                     20200303,
+	}
+	for texto, esperado := range validos {
+		f, err := NewFechaFromTexto(texto)
+		assert.Nil(err, texto)
+		assert.Equal(esperado, f, texto)
+	}
+
+	{ // Sin año
+		f, err := NewFechaFromTexto("1° de enero")
+		assert.Nil(err)
+		assert.Equal(NewFechaFromInts(time.Now().Year(), 1, 1), f)
+	}
+
+	invalidos := []string{
+		"",
+		"agosto de 2020",
+		"31 de febrero de 2020",
+		"29 de febrero de 2021",
+		"10001 de agosto de 2020",
+		"0 de agosto de 2020",
+		"32/01/2020",
+		"30/02/2020",
+		"23 de agostos de 2020",
+		"23 de agosto de 20200",
+		"23/13/2020",
+		"23 agosto septiembre 2020",
+	}
+	for _, texto := range invalidos {
+		_, err := NewFechaFromTexto(texto)
+		assert.NotNil(err, texto)
+	}
+}
+
+func TestNewMesFromTexto(t *testing.T) {
+	assert := assert.New(t)
+
+	validos := map[string]Mes{
+		"agosto 2020":    {2020, 8},
+		"Agosto de 2020": {2020, 8},
+		"ago/2020":       {2020, 8},
+		"AGO-20":         {2020, 8},
+		"08/2020":        {2020, 8},
+		"2020-08":        {2020, 8},
+		"set 2021":       {2021, 9},
+		"mar-21":         {2021, 3},
+	}
+	for texto, esperado := range validos {
+		m, err := NewMesFromTexto(texto)
+		assert.Nil(err, texto)
+		assert.Equal(esperado, m, texto)
+	}
+
+	invalidos := []string{
+		"",
+		"agosto",
+		"23 de agosto de 2020",
+		"13/2020",
+		"agosto 1800",
+	}
+	for _, texto := range invalidos {
+		_, err := NewMesFromTexto(texto)
+		assert.NotNil(err, texto)
+	}
+}
@@ -0,0 +1,53 @@
+package fecha
+
+// Pascua devuelve el domingo de Pascua del calendario gregoriano
+// (algoritmo anónimo de Meeus/Jones/Butcher).
+func Pascua(año int) Fecha {
+	a := año % 19
+	b := año / 100
+	c := año % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	mes := (h + l - 7*m + 114) / 31
+	dia := (h+l-7*m+114)%31 + 1
+	return NewFechaFromInts(año, mes, dia)
+}
+
+// LunesDeCarnaval devuelve el lunes de Carnaval (48 días antes de Pascua).
+func LunesDeCarnaval(año int) Fecha {
+	return Pascua(año).AgregarDias(-48)
+}
+
+// MartesDeCarnaval devuelve el martes de Carnaval (47 días antes de Pascua).
+func MartesDeCarnaval(año int) Fecha {
+	return Pascua(año).AgregarDias(-47)
+}
+
+// JuevesSanto devuelve el jueves anterior a Pascua.
+func JuevesSanto(año int) Fecha {
+	return Pascua(año).AgregarDias(-3)
+}
+
+// ViernesSanto devuelve el viernes anterior a Pascua.
+func ViernesSanto(año int) Fecha {
+	return Pascua(año).AgregarDias(-2)
+}
+
+// Ascension devuelve el jueves de la Ascensión (39 días después de Pascua).
+// En los países donde es feriado suele trasladarse al lunes siguiente.
+func Ascension(año int) Fecha {
+	return Pascua(año).AgregarDias(39)
+}
+
+// CorpusChristi devuelve el jueves de Corpus Christi (60 días después de Pascua).
+// En los países donde es feriado suele trasladarse al lunes siguiente.
+func CorpusChristi(año int) Fecha {
+	return Pascua(año).AgregarDias(60)
+}
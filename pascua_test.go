@@ -0,0 +1,29 @@
+package fecha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPascua(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Fecha(18180322), Pascua(1818)) // La más temprana posible
+	assert.Equal(Fecha(19430425), Pascua(1943)) // La más tardía posible
+	assert.Equal(Fecha(20190421), Pascua(2019))
+	assert.Equal(Fecha(20200412), Pascua(2020))
+	assert.Equal(Fecha(20210404), Pascua(2021))
+	assert.Equal(Fecha(20240331), Pascua(2024))
+	assert.Equal(Fecha(20250420), Pascua(2025))
+	assert.Equal(Fecha(20380425), Pascua(2038))
+}
+
+func TestFechasMovilesPascua(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Fecha(20240212), LunesDeCarnaval(2024))
+	assert.Equal(Fecha(20240213), MartesDeCarnaval(2024))
+	assert.Equal(Fecha(20240328), JuevesSanto(2024))
+	assert.Equal(Fecha(20240329), ViernesSanto(2024))
+	assert.Equal(Fecha(20240509), Ascension(2024))
+	assert.Equal(Fecha(20240530), CorpusChristi(2024))
+}
@@ -0,0 +1,268 @@
+package fecha
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Periodo es una duración expresada en años, meses, semanas y días, sin
+// componente horario. Se utiliza para plazos como "P3M" o "P1Y".
+//
+// Los valores pueden ser negativos. No se normalizan: "P14M" no es lo mismo
+// que "P1Y2M" al compararlos, aunque ambos sumen lo mismo a una fecha.
+//
+// En JSON se marshaliza como texto ISO 8601 ("P1Y2M10D").
+// En la base de datos se persiste como un INTERVAL.
+type Periodo struct {
+	Años    int
+	Meses   int
+	Semanas int
+	Dias    int
+}
+
+// NewPeriodoFromISO parsea una duración ISO 8601 de fechas, por ejemplo
+// "P1Y2M10D", "P3W" o "-P1M". Cada valor puede tener su propio signo ("P1Y-2M").
+// No se aceptan horas, minutos ni segundos.
+func NewPeriodoFromISO(str string) (p Periodo, err error) {
+	texto := str
+	negativo := false
+	switch {
+	case strings.HasPrefix(texto, "-"):
+		negativo = true
+		texto = texto[1:]
+	case strings.HasPrefix(texto, "+"):
+		texto = texto[1:]
+	}
+	if len(texto) < 3 || texto[0] != 'P' {
+		return p, fmt.Errorf("incorrect format: expected ISO 8601 duration like P1Y2M10D; got: '%v'", str)
+	}
+	texto = texto[1:]
+
+	// Orden en que deben aparecer las unidades
+	const unidades = "YMWD"
+	ultima := -1
+	for texto != "" {
+		i := strings.IndexAny(texto, unidades+"T")
+		if i == -1 {
+			return p, fmt.Errorf("missing unit in duration '%v'", str)
+		}
+		if texto[i] == 'T' {
+			return p, fmt.Errorf("duration '%v' has a time component", str)
+		}
+		n, err := strconv.Atoi(texto[:i])
+		if err != nil {
+			return p, fmt.Errorf("invalid number in duration '%v': %w", str, err)
+		}
+		unidad := strings.IndexByte(unidades, texto[i])
+		if unidad <= ultima {
+			return p, fmt.Errorf("unexpected unit '%c' in duration '%v'", texto[i], str)
+		}
+		ultima = unidad
+
+		switch texto[i] {
+		case 'Y':
+			p.Años = n
+		case 'M':
+			p.Meses = n
+		case 'W':
+			p.Semanas = n
+		case 'D':
+			p.Dias = n
+		}
+		texto = texto[i+1:]
+	}
+
+	if negativo {
+		p = p.Negativo()
+	}
+	return p, nil
+}
+
+// IsZero devuelve true si todos los valores son cero.
+func (p Periodo) IsZero() bool {
+	return p == Periodo{}
+}
+
+// Negativo devuelve el período con todos los valores cambiados de signo.
+func (p Periodo) Negativo() Periodo {
+	return Periodo{Años: -p.Años, Meses: -p.Meses, Semanas: -p.Semanas, Dias: -p.Dias}
+}
+
+// String devuelve la duración con formato ISO 8601 ("P1Y2M10D").
+// El período cero se muestra como "P0D". Si todos los valores son negativos
+// o cero, se antepone el signo ("-P1M").
+func (p Periodo) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	by := []byte{}
+	if p.Años <= 0 && p.Meses <= 0 && p.Semanas <= 0 && p.Dias <= 0 {
+		by = append(by, '-')
+		p = p.Negativo()
+	}
+	by = append(by, 'P')
+	return string(p.appendComponentes(by))
+}
+
+// Agrega los valores distintos de cero con su unidad, cada uno con su signo.
+func (p Periodo) appendComponentes(by []byte) []byte {
+	for _, v := range []struct {
+		n      int
+		unidad byte
+	}{
+		{p.Años, 'Y'},
+		{p.Meses, 'M'},
+		{p.Semanas, 'W'},
+		{p.Dias, 'D'},
+	} {
+		if v.n != 0 {
+			by = strconv.AppendInt(by, int64(v.n), 10)
+			by = append(by, v.unidad)
+		}
+	}
+	return by
+}
+
+// AgregarPeriodo devuelve la fecha con el período sumado.
+// Los años y meses se suman juntos con AgregarMeses (31/01 + P1M = 28/02,
+// 29/02/2020 + P1Y = 28/02/2021) y luego se suman las semanas y los días.
+// Se supone que se está trabajando con una fecha válida.
+func (f Fecha) AgregarPeriodo(p Periodo) Fecha {
+	return f.AgregarMeses(p.Años*12 + p.Meses).AgregarDias(p.Semanas*7 + p.Dias)
+}
+
+// RestarPeriodo devuelve la fecha con el período restado.
+// Se supone que se está trabajando con una fecha válida.
+func (f Fecha) RestarPeriodo(p Periodo) Fecha {
+	return f.AgregarPeriodo(p.Negativo())
+}
+
+// Periodo devuelve la diferencia como un Periodo.
+func (d Diferencia) Periodo() Periodo {
+	return Periodo{Años: d.Años, Meses: d.Meses, Dias: d.Dias}
+}
+
+// MarshalJSON devuelve el período como string ISO 8601.
+func (p Periodo) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + p.String() + `"`), nil
+}
+
+// UnmarshalJSON parsea un string ISO 8601.
+// Si llega una cadena null o vacía, se crea un período cero.
+func (p *Periodo) UnmarshalJSON(input []byte) (err error) {
+	texto, nulo := textoJSON(input)
+	if nulo {
+		*p = Periodo{}
+		return nil
+	}
+	*p, err = NewPeriodoFromISO(texto)
+	return
+}
+
+// Value satisface la interface de package sql.
+// Lo guarda como texto ISO 8601, que PostgreSQL acepta para el tipo INTERVAL.
+// Como PostgreSQL no acepta el signo antes de la P, cada valor lleva su
+// propio signo ("P-1M" en lugar de "-P1M").
+func (p Periodo) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return "P0D", nil
+	}
+	return string(p.appendComponentes([]byte{'P'})), nil
+}
+
+// Scan satisface la interface de package sql.
+// Acepta un INTERVAL en los formatos postgres ("1 year 2 mons 10 days"),
+// postgres_verbose ("@ 1 year 2 mons ago") e iso_8601 ("P1Y2M10D").
+// Si el intervalo tiene horas, minutos o segundos distintos de cero devuelve error.
+// NULL se lee como un período cero.
+func (p *Periodo) Scan(value interface{}) (err error) {
+	var texto string
+	switch v := value.(type) {
+	case nil:
+		*p = Periodo{}
+		return nil
+	case string:
+		texto = v
+	case []byte:
+		texto = string(v)
+	default:
+		return fmt.Errorf("expected value type: string, got: %T", value)
+	}
+
+	if strings.HasPrefix(texto, "P") || strings.HasPrefix(texto, "-P") {
+		*p, err = parsearIntervaloISO(texto)
+		return err
+	}
+	*p, err = parsearIntervalo(texto)
+	return err
+}
+
+// Parsea un INTERVAL de PostgreSQL en formato iso_8601. A diferencia de
+// NewPeriodoFromISO acepta una parte horaria en cero, ya que PostgreSQL
+// devuelve "PT0S" para el intervalo cero.
+func parsearIntervaloISO(texto string) (p Periodo, err error) {
+	fechaISO, hora, conHora := strings.Cut(texto, "T")
+	if conHora {
+		if hora == "" || strings.Trim(hora, "+-0.HMS") != "" {
+			return p, fmt.Errorf("interval '%v' has a time component", texto)
+		}
+		if fechaISO == "P" || fechaISO == "-P" {
+			return p, nil
+		}
+	}
+	return NewPeriodoFromISO(fechaISO)
+}
+
+// Parsea un INTERVAL de PostgreSQL en formato postgres o postgres_verbose.
+func parsearIntervalo(texto string) (p Periodo, err error) {
+	campos := strings.Fields(texto)
+	if len(campos) > 0 && campos[0] == "@" {
+		campos = campos[1:]
+	}
+	negativo := false
+	if len(campos) > 0 && campos[len(campos)-1] == "ago" {
+		negativo = true
+		campos = campos[:len(campos)-1]
+	}
+
+	for i := 0; i < len(campos); i++ {
+		if strings.Contains(campos[i], ":") {
+			if strings.Trim(campos[i], "-+0:.") != "" {
+				return p, fmt.Errorf("interval '%v' has a time component", texto)
+			}
+			continue
+		}
+		if i+1 >= len(campos) {
+			return p, fmt.Errorf("invalid interval '%v'", texto)
+		}
+		n, err := strconv.Atoi(campos[i])
+		if err != nil {
+			return p, fmt.Errorf("invalid interval '%v': %w", texto, err)
+		}
+		i++
+		switch strings.TrimSuffix(campos[i], "s") {
+		case "year":
+			p.Años = n
+		case "mon", "month":
+			p.Meses = n
+		case "week":
+			p.Semanas = n
+		case "day":
+			p.Dias = n
+		case "hour", "min", "minute", "sec", "second":
+			if n != 0 {
+				return p, fmt.Errorf("interval '%v' has a time component", texto)
+			}
+		default:
+			return p, fmt.Errorf("unknown unit '%v' in interval '%v'", campos[i], texto)
+		}
+	}
+
+	if negativo {
+		p = p.Negativo()
+	}
+	return p, nil
+}
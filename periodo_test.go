@@ -0,0 +1,168 @@
+package fecha
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeriodoFromISO(t *testing.T) {
+	assert := assert.New(t)
+
+	for texto, esperado := range map[string]Periodo{
+		"P1Y2M10D":  {Años: 1, Meses: 2, Dias: 10},
+		"P3M":       {Meses: 3},
+		"P1Y":       {Años: 1},
+		"P2W":       {Semanas: 2},
+		"P1Y2W":     {Años: 1, Semanas: 2},
+		"P0D":       {},
+		"-P1M":      {Meses: -1},
+		"+P1M":      {Meses: 1},
+		"P1Y-2M":    {Años: 1, Meses: -2},
+		"-P1Y2M3D":  {Años: -1, Meses: -2, Dias: -3},
+		"P14M":      {Meses: 14},
+		"P0Y0M400D": {Dias: 400},
+	} {
+		p, err := NewPeriodoFromISO(texto)
+		assert.Nil(err, texto)
+		assert.Equal(esperado, p, texto)
+	}
+
+	for _, texto := range []string{"", "P", "1Y", "P1", "PY", "P1M1Y", "P1D1D", "PT1H", "P1DT2H", "P1.5Y", "P1X"} {
+		_, err := NewPeriodoFromISO(texto)
+		assert.NotNil(err, texto)
+	}
+}
+
+func TestPeriodoString(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("P1Y2M10D", Periodo{Años: 1, Meses: 2, Dias: 10}.String())
+	assert.Equal("P3W", Periodo{Semanas: 3}.String())
+	assert.Equal("P0D", Periodo{}.String())
+	assert.Equal("-P1Y2M", Periodo{Años: -1, Meses: -2}.String())
+	assert.Equal("P1Y-2M", Periodo{Años: 1, Meses: -2}.String())
+
+	for _, v := range []Periodo{{1, 2, 3, 4}, {0, -1, 0, 0}, {1, -1, 0, 5}} {
+		p, err := NewPeriodoFromISO(v.String())
+		assert.Nil(err)
+		assert.Equal(v, p)
+	}
+}
+
+func TestAgregarPeriodo(t *testing.T) {
+	assert := assert.New(t)
+
+	f := Fecha(20210131)
+	assert.Equal(Fecha(20210228), f.AgregarPeriodo(Periodo{Meses: 1}))
+	assert.Equal(Fecha(20220131), f.AgregarPeriodo(Periodo{Años: 1}))
+	assert.Equal(Fecha(20220314), f.AgregarPeriodo(Periodo{Años: 1, Meses: 1, Semanas: 2}))
+	assert.Equal(Fecha(20201231), f.RestarPeriodo(Periodo{Meses: 1}))
+	assert.Equal(Fecha(20210228), Fecha(20200229).AgregarPeriodo(Periodo{Años: 1}))
+	assert.Equal(f, f.AgregarPeriodo(Periodo{}))
+
+	// Desde una diferencia
+	desde, hasta := Fecha(20170310), Fecha(20200515)
+	assert.Equal(hasta, desde.AgregarPeriodo(DiferenciaEntre(desde, hasta).Periodo()))
+	assert.Equal(desde, hasta.AgregarPeriodo(DiferenciaEntre(hasta, desde).Periodo()))
+}
+
+func TestJSONPeriodo(t *testing.T) {
+	assert := assert.New(t)
+
+	type contrato struct {
+		Plazo Periodo `json:"plazo"`
+	}
+
+	by, err := json.Marshal(contrato{Periodo{Meses: 3}})
+	assert.Nil(err)
+	assert.Equal(`{"plazo":"P3M"}`, string(by))
+
+	c := contrato{}
+	assert.Nil(json.Unmarshal([]byte(`{"plazo":"P1Y"}`), &c))
+	assert.Equal(Periodo{Años: 1}, c.Plazo)
+
+	assert.Nil(json.Unmarshal([]byte(`{"plazo":null}`), &c))
+	assert.True(c.Plazo.IsZero())
+
+	assert.NotNil(json.Unmarshal([]byte(`{"plazo":"3 meses"}`), &c))
+}
+
+func TestSQLPeriodo(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := Periodo{Años: 1, Meses: 2, Dias: 10}.Value()
+	assert.Nil(err)
+	assert.Equal("P1Y2M10D", v)
+
+	for texto, esperado := range map[string]Periodo{
+		"1 year 2 mons 10 days":          {Años: 1, Meses: 2, Dias: 10},
+		"3 mons":                         {Meses: 3},
+		"-1 years -2 mons":               {Años: -1, Meses: -2},
+		"21 days":                        {Dias: 21},
+		"00:00:00":                       {},
+		"1 day 00:00:00":                 {Dias: 1},
+		"@ 1 year 2 mons":                {Años: 1, Meses: 2},
+		"@ 1 year 2 mons ago":            {Años: -1, Meses: -2},
+		"@ 3 days 0 hours 0 mins 0 secs": {Dias: 3},
+		"P1Y2M10D":                       {Años: 1, Meses: 2, Dias: 10},
+		"-P3M":                           {Meses: -3},
+	} {
+		p := Periodo{}
+		assert.Nil(p.Scan(texto), texto)
+		assert.Equal(esperado, p, texto)
+	}
+
+	p := Periodo{Meses: 1}
+	assert.Nil(p.Scan([]byte("1 year")))
+	assert.Equal(Periodo{Años: 1}, p)
+	assert.Nil(p.Scan(nil))
+	assert.True(p.IsZero())
+
+	for _, v := range []interface{}{"1 day 01:00:00", "@ 1 hour", "PT1H", "P1DT0H1S", "PT", "1 fortnight", "year", 3} {
+		assert.NotNil(p.Scan(v), v)
+	}
+
+	// Formato iso_8601 de PostgreSQL
+	for texto, esperado := range map[string]Periodo{
+		"PT0S":     {},
+		"P1DT0S":   {Dias: 1},
+		"P-1M":     {Meses: -1},
+		"P1Y-2M":   {Años: 1, Meses: -2},
+		"P1Y2MT0S": {Años: 1, Meses: 2},
+	} {
+		p := Periodo{Dias: 3}
+		assert.Nil(p.Scan(texto), texto)
+		assert.Equal(esperado, p, texto)
+	}
+}
+
+func TestPeriodoSQLIdaYVuelta(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, v := range []Periodo{
+		{},
+		{Meses: -1},
+		{Años: -1, Meses: -2, Dias: -10},
+		{Años: 1, Meses: -2},
+		{Semanas: 3},
+	} {
+		valor, err := v.Value()
+		assert.Nil(err)
+		texto := valor.(string)
+		assert.True(strings.HasPrefix(texto, "P"), texto)
+
+		p := Periodo{Dias: 7}
+		assert.Nil(p.Scan(texto), texto)
+		assert.Equal(v, p, texto)
+	}
+
+	v, err := Periodo{Meses: -1}.Value()
+	assert.Nil(err)
+	assert.Equal("P-1M", v)
+	v, err = Periodo{}.Value()
+	assert.Nil(err)
+	assert.Equal("P0D", v)
+}
@@ -0,0 +1,629 @@
+package fecha
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Trimestre representa un trimestre calendario de un año particular.
+//
+// En JSON se marshaliza con el formato "2020-Q3".
+// En la base de datos se persiste como un DATE (el primer día del trimestre).
+type Trimestre struct {
+	año       int
+	trimestre int
+}
+
+// Semestre representa un semestre calendario de un año particular.
+//
+// En JSON se marshaliza con el formato "2020-S2".
+// En la base de datos se persiste como un DATE (el primer día del semestre).
+type Semestre struct {
+	año      int
+	semestre int
+}
+
+// Año representa un año calendario.
+//
+// En JSON se marshaliza con el formato "2020".
+// En la base de datos se persiste como un DATE (el 1 de enero).
+type Año struct {
+	año int
+}
+
+var (
+	NilTrimestre = Trimestre{}
+	NilSemestre  = Semestre{}
+	NilAño       = Año{}
+)
+
+// NewTrimestre crea un trimestre (1 a 4) validando sus valores.
+func NewTrimestre(año, trimestre int) (Trimestre, error) {
+	t := Trimestre{año, trimestre}
+	if trimestre < 1 || trimestre > 4 {
+		return t, fmt.Errorf("invalid quarter '%v' (must be between 1 and 4)", trimestre)
+	}
+	return t, validarAño(año)
+}
+
+func NewTrimestreMust(año, trimestre int) Trimestre {
+	t, err := NewTrimestre(año, trimestre)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// NewTrimestreFromJSON parsea un trimestre con el formato "2020-Q3".
+func NewTrimestreFromJSON(str string) (out Trimestre, err error) {
+	año, trimestre, err := parsearPeriodo(str, 'Q')
+	if err != nil {
+		return out, err
+	}
+	return NewTrimestre(año, trimestre)
+}
+
+// NewSemestre crea un semestre (1 o 2) validando sus valores.
+func NewSemestre(año, semestre int) (Semestre, error) {
+	s := Semestre{año, semestre}
+	if semestre < 1 || semestre > 2 {
+		return s, fmt.Errorf("invalid semester '%v' (must be 1 or 2)", semestre)
+	}
+	return s, validarAño(año)
+}
+
+func NewSemestreMust(año, semestre int) Semestre {
+	s, err := NewSemestre(año, semestre)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewSemestreFromJSON parsea un semestre con el formato "2020-S2".
+func NewSemestreFromJSON(str string) (out Semestre, err error) {
+	año, semestre, err := parsearPeriodo(str, 'S')
+	if err != nil {
+		return out, err
+	}
+	return NewSemestre(año, semestre)
+}
+
+// NewAño crea un año validando que esté entre AñoMinimo y AñoMaximo.
+func NewAño(año int) (Año, error) {
+	return Año{año}, validarAño(año)
+}
+
+func NewAñoMust(año int) Año {
+	a, err := NewAño(año)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// NewAñoFromJSON parsea un año con el formato "2020".
+func NewAñoFromJSON(str string) (out Año, err error) {
+	if len(str) != 4 {
+		return out, fmt.Errorf("incorrect format: expected YYYY; got: %v", str)
+	}
+	año, err := strconv.Atoi(str)
+	if err != nil {
+		return out, fmt.Errorf("invalid year '%v': %w", str, err)
+	}
+	return NewAño(año)
+}
+
+// PeriodoTrimestre devuelve el trimestre correspondiente a la fecha.
+// Se supone que se está trabajando con una fecha válida.
+// Infinito y MenosInfinito devuelven NilTrimestre.
+func (f Fecha) PeriodoTrimestre() Trimestre {
+	if f.IsInfinite() {
+		return NilTrimestre
+	}
+	return f.PeriodoMes().PeriodoTrimestre()
+}
+
+// PeriodoSemestre devuelve el semestre correspondiente a la fecha.
+// Se supone que se está trabajando con una fecha válida.
+// Infinito y MenosInfinito devuelven NilSemestre.
+func (f Fecha) PeriodoSemestre() Semestre {
+	if f.IsInfinite() {
+		return NilSemestre
+	}
+	return f.PeriodoMes().PeriodoSemestre()
+}
+
+// PeriodoAño devuelve el año correspondiente a la fecha.
+// Se supone que se está trabajando con una fecha válida.
+// Infinito y MenosInfinito devuelven NilAño.
+func (f Fecha) PeriodoAño() Año {
+	if f.IsInfinite() {
+		return NilAño
+	}
+	return Año{f.Año()}
+}
+
+// PeriodoTrimestre devuelve el trimestre que contiene al mes.
+// MesInfinito y MesMenosInfinito devuelven NilTrimestre.
+func (m Mes) PeriodoTrimestre() Trimestre {
+	if m.IsInfinite() {
+		return NilTrimestre
+	}
+	return Trimestre{m.año, (m.mes + 2) / 3}
+}
+
+// PeriodoSemestre devuelve el semestre que contiene al mes.
+// MesInfinito y MesMenosInfinito devuelven NilSemestre.
+func (m Mes) PeriodoSemestre() Semestre {
+	if m.IsInfinite() {
+		return NilSemestre
+	}
+	return Semestre{m.año, (m.mes + 5) / 6}
+}
+
+// PeriodoAño devuelve el año del mes.
+// MesInfinito y MesMenosInfinito devuelven NilAño.
+func (m Mes) PeriodoAño() Año {
+	if m.IsInfinite() {
+		return NilAño
+	}
+	return Año{m.año}
+}
+
+// TrimestreDelAño devuelve el número de trimestre (1 a 4).
+func (t Trimestre) TrimestreDelAño() int {
+	return t.trimestre
+}
+
+// Año devuelve el número del año.
+func (t Trimestre) Año() int {
+	return t.año
+}
+
+// Valid devuelve true si el trimestre es válido.
+func (t Trimestre) Valid() bool {
+	return t.trimestre >= 1 && t.trimestre <= 4 && validarAño(t.año) == nil
+}
+
+// Zero devuelve true si el trimestre y el año son cero.
+func (t Trimestre) Zero() bool {
+	return t == Trimestre{}
+}
+
+func (t Trimestre) Anterior(t2 Trimestre) bool {
+	return t.indice() < t2.indice()
+}
+
+func (t Trimestre) AnteriorOIgual(t2 Trimestre) bool {
+	return t.indice() <= t2.indice()
+}
+
+func (t Trimestre) Posterior(t2 Trimestre) bool {
+	return t.indice() > t2.indice()
+}
+
+func (t Trimestre) PosteriorOIgual(t2 Trimestre) bool {
+	return t.indice() >= t2.indice()
+}
+
+// SumarTrimestres devuelve un nuevo trimestre con los trimestres agregados.
+// Si se quiere restar, ingresar trimestres en negativo.
+// Se supone que se está trabajando con un Trimestre válido no cero.
+func (t Trimestre) SumarTrimestres(trimestres int) Trimestre {
+	i := t.indice() + trimestres
+	return Trimestre{divPiso(i, 4), modPiso(i, 4) + 1}
+}
+
+// PrimerMes devuelve el primer mes del trimestre.
+func (t Trimestre) PrimerMes() Mes {
+	return Mes{t.año, t.trimestre*3 - 2}
+}
+
+// UltimoMes devuelve el último mes del trimestre.
+func (t Trimestre) UltimoMes() Mes {
+	return Mes{t.año, t.trimestre * 3}
+}
+
+// PrimerDia devuelve la fecha considerando el primer día del período.
+func (t Trimestre) PrimerDia() Fecha {
+	return t.PrimerMes().PrimerDia()
+}
+
+// UltimoDia devuelve la fecha considerando el último día del período.
+func (t Trimestre) UltimoDia() Fecha {
+	return t.UltimoMes().UltimoDia()
+}
+
+// String devuelve el trimestre con el formato "T3/2020".
+func (t Trimestre) String() string {
+	if !t.Valid() {
+		return "trimestre inválido"
+	}
+	return stringPeriodo('T', t.trimestre, t.año)
+}
+
+// JSONString devuelve la representación que se utiliza en JSON ("2020-Q3").
+// Si no es válido devuelve null.
+func (t Trimestre) JSONString() string {
+	if !t.Valid() {
+		return "null"
+	}
+	return string(appendPeriodoJSON(nil, t.año, 'Q', t.trimestre))
+}
+
+// Value satisface la interface de package sql.
+// En la base de datos lo guarda como el tipo DATE.
+// Si se intenta persistir un trimestre Zero() => lo guarda como null.
+func (t Trimestre) Value() (driver.Value, error) {
+	if t.Zero() {
+		return nil, nil
+	}
+	if !t.Valid() {
+		return nil, fmt.Errorf("invalid quarter '%v-Q%v'", t.año, t.trimestre)
+	}
+	return t.PrimerDia().Time(), nil
+}
+
+// Scan satisface la interface de package sql.
+// Si la fecha persistida no es el primer día, devuelve el trimestre que la contiene.
+func (t *Trimestre) Scan(value interface{}) error {
+	f, err := scanPeriodo(value)
+	if err != nil {
+		return err
+	}
+	*t = Trimestre{}
+	if f != 0 {
+		*t = f.PeriodoTrimestre()
+	}
+	return nil
+}
+
+// MarshalJSON es para tomar una struct a un string JSON.
+func (t Trimestre) MarshalJSON() ([]byte, error) {
+	if t.Zero() {
+		return []byte("null"), nil
+	}
+	if !t.Valid() {
+		return nil, fmt.Errorf("cannot marshal invalid quarter '%v-Q%v'", t.año, t.trimestre)
+	}
+	by := appendPeriodoJSON([]byte{'"'}, t.año, 'Q', t.trimestre)
+	return append(by, '"'), nil
+}
+
+// UnmarshalJSON es para parsear el string a una struct Trimestre.
+// Si llega una cadena null o vacía, se crea una struct con valor cero.
+func (t *Trimestre) UnmarshalJSON(input []byte) (err error) {
+	texto, nulo := textoJSON(input)
+	if nulo {
+		*t = Trimestre{}
+		return nil
+	}
+	*t, err = NewTrimestreFromJSON(texto)
+	return
+}
+
+func (t Trimestre) indice() int {
+	return t.año*4 + t.trimestre - 1
+}
+
+// SemestreDelAño devuelve el número de semestre (1 o 2).
+func (s Semestre) SemestreDelAño() int {
+	return s.semestre
+}
+
+// Año devuelve el número del año.
+func (s Semestre) Año() int {
+	return s.año
+}
+
+// Valid devuelve true si el semestre es válido.
+func (s Semestre) Valid() bool {
+	return s.semestre >= 1 && s.semestre <= 2 && validarAño(s.año) == nil
+}
+
+// Zero devuelve true si el semestre y el año son cero.
+func (s Semestre) Zero() bool {
+	return s == Semestre{}
+}
+
+func (s Semestre) Anterior(s2 Semestre) bool {
+	return s.indice() < s2.indice()
+}
+
+func (s Semestre) AnteriorOIgual(s2 Semestre) bool {
+	return s.indice() <= s2.indice()
+}
+
+func (s Semestre) Posterior(s2 Semestre) bool {
+	return s.indice() > s2.indice()
+}
+
+func (s Semestre) PosteriorOIgual(s2 Semestre) bool {
+	return s.indice() >= s2.indice()
+}
+
+// SumarSemestres devuelve un nuevo semestre con los semestres agregados.
+// Si se quiere restar, ingresar semestres en negativo.
+// Se supone que se está trabajando con un Semestre válido no cero.
+func (s Semestre) SumarSemestres(semestres int) Semestre {
+	i := s.indice() + semestres
+	return Semestre{divPiso(i, 2), modPiso(i, 2) + 1}
+}
+
+// PrimerMes devuelve el primer mes del semestre.
+func (s Semestre) PrimerMes() Mes {
+	return Mes{s.año, s.semestre*6 - 5}
+}
+
+// UltimoMes devuelve el último mes del semestre.
+func (s Semestre) UltimoMes() Mes {
+	return Mes{s.año, s.semestre * 6}
+}
+
+// PrimerDia devuelve la fecha considerando el primer día del período.
+func (s Semestre) PrimerDia() Fecha {
+	return s.PrimerMes().PrimerDia()
+}
+
+// UltimoDia devuelve la fecha considerando el último día del período.
+func (s Semestre) UltimoDia() Fecha {
+	return s.UltimoMes().UltimoDia()
+}
+
+// String devuelve el semestre con el formato "S2/2020".
+func (s Semestre) String() string {
+	if !s.Valid() {
+		return "semestre inválido"
+	}
+	return stringPeriodo('S', s.semestre, s.año)
+}
+
+// JSONString devuelve la representación que se utiliza en JSON ("2020-S2").
+// Si no es válido devuelve null.
+func (s Semestre) JSONString() string {
+	if !s.Valid() {
+		return "null"
+	}
+	return string(appendPeriodoJSON(nil, s.año, 'S', s.semestre))
+}
+
+// Value satisface la interface de package sql.
+// En la base de datos lo guarda como el tipo DATE.
+// Si se intenta persistir un semestre Zero() => lo guarda como null.
+func (s Semestre) Value() (driver.Value, error) {
+	if s.Zero() {
+		return nil, nil
+	}
+	if !s.Valid() {
+		return nil, fmt.Errorf("invalid semester '%v-S%v'", s.año, s.semestre)
+	}
+	return s.PrimerDia().Time(), nil
+}
+
+// Scan satisface la interface de package sql.
+// Si la fecha persistida no es el primer día, devuelve el semestre que la contiene.
+func (s *Semestre) Scan(value interface{}) error {
+	f, err := scanPeriodo(value)
+	if err != nil {
+		return err
+	}
+	*s = Semestre{}
+	if f != 0 {
+		*s = f.PeriodoSemestre()
+	}
+	return nil
+}
+
+// MarshalJSON es para tomar una struct a un string JSON.
+func (s Semestre) MarshalJSON() ([]byte, error) {
+	if s.Zero() {
+		return []byte("null"), nil
+	}
+	if !s.Valid() {
+		return nil, fmt.Errorf("cannot marshal invalid semester '%v-S%v'", s.año, s.semestre)
+	}
+	by := appendPeriodoJSON([]byte{'"'}, s.año, 'S', s.semestre)
+	return append(by, '"'), nil
+}
+
+// UnmarshalJSON es para parsear el string a una struct Semestre.
+// Si llega una cadena null o vacía, se crea una struct con valor cero.
+func (s *Semestre) UnmarshalJSON(input []byte) (err error) {
+	texto, nulo := textoJSON(input)
+	if nulo {
+		*s = Semestre{}
+		return nil
+	}
+	*s, err = NewSemestreFromJSON(texto)
+	return
+}
+
+func (s Semestre) indice() int {
+	return s.año*2 + s.semestre - 1
+}
+
+// Año devuelve el número del año.
+func (a Año) Año() int {
+	return a.año
+}
+
+// Valid devuelve true si el año está entre AñoMinimo y AñoMaximo.
+func (a Año) Valid() bool {
+	return validarAño(a.año) == nil
+}
+
+// Zero devuelve true si el año es cero.
+func (a Año) Zero() bool {
+	return a.año == 0
+}
+
+func (a Año) Anterior(a2 Año) bool {
+	return a.año < a2.año
+}
+
+func (a Año) AnteriorOIgual(a2 Año) bool {
+	return a.año <= a2.año
+}
+
+func (a Año) Posterior(a2 Año) bool {
+	return a.año > a2.año
+}
+
+func (a Año) PosteriorOIgual(a2 Año) bool {
+	return a.año >= a2.año
+}
+
+// SumarAños devuelve un nuevo año con los años agregados.
+// Si se quiere restar, ingresar años en negativo.
+func (a Año) SumarAños(años int) Año {
+	return Año{a.año + años}
+}
+
+// PrimerMes devuelve enero del año.
+func (a Año) PrimerMes() Mes {
+	return Mes{a.año, 1}
+}
+
+// UltimoMes devuelve diciembre del año.
+func (a Año) UltimoMes() Mes {
+	return Mes{a.año, 12}
+}
+
+// PrimerDia devuelve la fecha considerando el primer día del período.
+func (a Año) PrimerDia() Fecha {
+	return fechaDesdeCivil(a.año, 1, 1)
+}
+
+// UltimoDia devuelve la fecha considerando el último día del período.
+func (a Año) UltimoDia() Fecha {
+	return fechaDesdeCivil(a.año, 12, 31)
+}
+
+// String devuelve el año con el formato "2020".
+func (a Año) String() string {
+	if !a.Valid() {
+		return "año inválido"
+	}
+	return strconv.Itoa(a.año)
+}
+
+// JSONString devuelve la representación que se utiliza en JSON ("2020").
+// Si no es válido devuelve null.
+func (a Año) JSONString() string {
+	if !a.Valid() {
+		return "null"
+	}
+	return string(appendCuatroDigitos(nil, a.año))
+}
+
+// Value satisface la interface de package sql.
+// En la base de datos lo guarda como el tipo DATE.
+// Si se intenta persistir un año Zero() => lo guarda como null.
+func (a Año) Value() (driver.Value, error) {
+	if a.Zero() {
+		return nil, nil
+	}
+	if !a.Valid() {
+		return nil, validarAño(a.año)
+	}
+	return a.PrimerDia().Time(), nil
+}
+
+// Scan satisface la interface de package sql.
+// Si la fecha persistida no es el 1 de enero, devuelve el año que la contiene.
+func (a *Año) Scan(value interface{}) error {
+	f, err := scanPeriodo(value)
+	if err != nil {
+		return err
+	}
+	*a = Año{}
+	if f != 0 {
+		*a = f.PeriodoAño()
+	}
+	return nil
+}
+
+// MarshalJSON es para tomar una struct a un string JSON.
+func (a Año) MarshalJSON() ([]byte, error) {
+	if a.Zero() {
+		return []byte("null"), nil
+	}
+	if !a.Valid() {
+		return nil, fmt.Errorf("cannot marshal invalid year '%v'", a.año)
+	}
+	by := appendCuatroDigitos([]byte{'"'}, a.año)
+	return append(by, '"'), nil
+}
+
+// UnmarshalJSON es para parsear el string a una struct Año.
+// Acepta tanto "2020" como 2020.
+// Si llega una cadena null o vacía, se crea una struct con valor cero.
+func (a *Año) UnmarshalJSON(input []byte) (err error) {
+	texto, nulo := textoJSON(input)
+	if nulo {
+		*a = Año{}
+		return nil
+	}
+	*a, err = NewAñoFromJSON(texto)
+	return
+}
+
+func validarAño(año int) error {
+	if año < AñoMinimo || año > AñoMaximo {
+		return fmt.Errorf("year '%v' out of range (must be between %v and %v)", año, AñoMinimo, AñoMaximo)
+	}
+	return nil
+}
+
+// Parsea los formatos "2020-Q3" y "2020-S2".
+func parsearPeriodo(str string, letra byte) (año, numero int, err error) {
+	if len(str) != 7 || str[4] != '-' || str[5] != letra {
+		return 0, 0, fmt.Errorf("incorrect format: expected YYYY-%cN; got: %v", letra, str)
+	}
+	año, err = strconv.Atoi(str[:4])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid year '%v': %w", str, err)
+	}
+	numero, err = strconv.Atoi(str[6:])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid period '%v': %w", str, err)
+	}
+	return año, numero, nil
+}
+
+func appendPeriodoJSON(by []byte, año int, letra byte, numero int) []byte {
+	by = appendCuatroDigitos(by, año)
+	return append(by, '-', letra, byte('0'+numero))
+}
+
+func stringPeriodo(letra byte, numero, año int) string {
+	periodo := string([]byte{letra, byte('0' + numero)})
+	if MesPrimero {
+		return fmt.Sprintf("%v%v%v", periodo, SeparadorMes, año)
+	}
+	return fmt.Sprintf("%v%v%v", año, SeparadorMes, periodo)
+}
+
+// Devuelve el texto sin comillas, o true si es null o vacío.
+func textoJSON(input []byte) (texto string, nulo bool) {
+	input = bytes.TrimSpace(input)
+	if string(input) == "null" || string(input) == `""` {
+		return "", true
+	}
+	return string(bytes.Trim(input, `"`)), false
+}
+
+// Devuelve la fecha persistida, o cero si es NULL.
+func scanPeriodo(value interface{}) (Fecha, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case time.Time:
+		return NewFechaFromTime(v), nil
+	}
+	return 0, fmt.Errorf("expected value type: time.Time, got: %T", value)
+}
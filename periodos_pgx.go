@@ -0,0 +1,378 @@
+package fecha
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgtype"
+)
+
+var _ pgtype.ValueTranscoder = (*Trimestre)(nil)
+var _ pgtype.TypeValue = (*Trimestre)(nil)
+var _ pgtype.ValueTranscoder = (*Semestre)(nil)
+var _ pgtype.TypeValue = (*Semestre)(nil)
+var _ pgtype.ValueTranscoder = (*Año)(nil)
+var _ pgtype.TypeValue = (*Año)(nil)
+
+// DecodeBinary lee un DATE. Si la fecha no es el primer día del período,
+// devuelve el período que la contiene.
+func (t *Trimestre) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	f, err := decodificarDate(ci, src, true)
+	if err != nil {
+		return err
+	}
+	return t.Set(f)
+}
+
+// DecodeText lee un DATE en formato texto.
+func (t *Trimestre) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	f, err := decodificarDate(ci, src, false)
+	if err != nil {
+		return err
+	}
+	return t.Set(f)
+}
+
+// EncodeBinary guarda el primer día del período. Si es cero lo guarda como null.
+func (t Trimestre) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := datePeriodo(t)
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeBinary(ci, buf)
+}
+
+// EncodeText guarda el primer día del período. Si es cero lo guarda como null.
+func (t Trimestre) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := datePeriodo(t)
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeText(ci, buf)
+}
+
+// TypeName returns the PostgreSQL name of this type.
+func (Trimestre) TypeName() string {
+	return "date"
+}
+
+func (t *Trimestre) NewTypeValue() pgtype.Value {
+	return new(Trimestre)
+}
+
+// Set acepta un Trimestre, una Fecha, un time.Time o un string con el formato de JSON.
+func (t *Trimestre) Set(src interface{}) (err error) {
+	switch x := src.(type) {
+	case nil:
+		*t = Trimestre{}
+	case Trimestre:
+		*t = x
+	case *Trimestre:
+		*t = Trimestre{}
+		if x != nil {
+			*t = *x
+		}
+	case Fecha:
+		if x.IsInfinite() {
+			return fmt.Errorf("cannot convert infinite date to Trimestre")
+		}
+		if x != 0 && !x.IsValid() {
+			return fmt.Errorf("invalid date '%v'", int(x))
+		}
+		*t = Trimestre{}
+		if x != 0 {
+			*t = x.PeriodoTrimestre()
+		}
+	case time.Time:
+		return t.Set(NewFechaFromTime(x))
+	case string:
+		*t, err = NewTrimestreFromJSON(x)
+	default:
+		return fmt.Errorf("cannot convert %v to Trimestre", src)
+	}
+	return err
+}
+
+// Get devuelve nil si es cero.
+func (t *Trimestre) Get() interface{} {
+	if t.Zero() {
+		return nil
+	}
+	return *t
+}
+
+// AssignTo acepta *Trimestre, *Fecha, *time.Time o punteros a punteros de
+// ellos. Si es cero, los punteros a punteros quedan en nil.
+func (t *Trimestre) AssignTo(dst interface{}) error {
+	switch x := dst.(type) {
+	case *Trimestre:
+		*x = *t
+	case *Fecha:
+		*x = 0
+		if !t.Zero() {
+			*x = t.PrimerDia()
+		}
+	case *time.Time:
+		if t.Zero() {
+			return fmt.Errorf("cannot assign null Trimestre to %T", dst)
+		}
+		*x = t.PrimerDia().Time()
+	default:
+		if t.Zero() {
+			return pgtype.NullAssignTo(dst)
+		}
+		if siguiente, ok := pgtype.GetAssignToDstType(dst); ok {
+			return t.AssignTo(siguiente)
+		}
+		return fmt.Errorf("cannot assign Trimestre to %T", dst)
+	}
+	return nil
+}
+
+// DecodeBinary lee un DATE. Si la fecha no es el primer día del período,
+// devuelve el período que la contiene.
+func (s *Semestre) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	f, err := decodificarDate(ci, src, true)
+	if err != nil {
+		return err
+	}
+	return s.Set(f)
+}
+
+// DecodeText lee un DATE en formato texto.
+func (s *Semestre) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	f, err := decodificarDate(ci, src, false)
+	if err != nil {
+		return err
+	}
+	return s.Set(f)
+}
+
+// EncodeBinary guarda el primer día del período. Si es cero lo guarda como null.
+func (s Semestre) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := datePeriodo(s)
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeBinary(ci, buf)
+}
+
+// EncodeText guarda el primer día del período. Si es cero lo guarda como null.
+func (s Semestre) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := datePeriodo(s)
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeText(ci, buf)
+}
+
+// TypeName returns the PostgreSQL name of this type.
+func (Semestre) TypeName() string {
+	return "date"
+}
+
+func (s *Semestre) NewTypeValue() pgtype.Value {
+	return new(Semestre)
+}
+
+// Set acepta un Semestre, una Fecha, un time.Time o un string con el formato de JSON.
+func (s *Semestre) Set(src interface{}) (err error) {
+	switch x := src.(type) {
+	case nil:
+		*s = Semestre{}
+	case Semestre:
+		*s = x
+	case *Semestre:
+		*s = Semestre{}
+		if x != nil {
+			*s = *x
+		}
+	case Fecha:
+		if x.IsInfinite() {
+			return fmt.Errorf("cannot convert infinite date to Semestre")
+		}
+		if x != 0 && !x.IsValid() {
+			return fmt.Errorf("invalid date '%v'", int(x))
+		}
+		*s = Semestre{}
+		if x != 0 {
+			*s = x.PeriodoSemestre()
+		}
+	case time.Time:
+		return s.Set(NewFechaFromTime(x))
+	case string:
+		*s, err = NewSemestreFromJSON(x)
+	default:
+		return fmt.Errorf("cannot convert %v to Semestre", src)
+	}
+	return err
+}
+
+// Get devuelve nil si es cero.
+func (s *Semestre) Get() interface{} {
+	if s.Zero() {
+		return nil
+	}
+	return *s
+}
+
+// AssignTo acepta *Semestre, *Fecha, *time.Time o punteros a punteros de
+// ellos. Si es cero, los punteros a punteros quedan en nil.
+func (s *Semestre) AssignTo(dst interface{}) error {
+	switch x := dst.(type) {
+	case *Semestre:
+		*x = *s
+	case *Fecha:
+		*x = 0
+		if !s.Zero() {
+			*x = s.PrimerDia()
+		}
+	case *time.Time:
+		if s.Zero() {
+			return fmt.Errorf("cannot assign null Semestre to %T", dst)
+		}
+		*x = s.PrimerDia().Time()
+	default:
+		if s.Zero() {
+			return pgtype.NullAssignTo(dst)
+		}
+		if siguiente, ok := pgtype.GetAssignToDstType(dst); ok {
+			return s.AssignTo(siguiente)
+		}
+		return fmt.Errorf("cannot assign Semestre to %T", dst)
+	}
+	return nil
+}
+
+// DecodeBinary lee un DATE. Si la fecha no es el primer día del período,
+// devuelve el período que la contiene.
+func (a *Año) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	f, err := decodificarDate(ci, src, true)
+	if err != nil {
+		return err
+	}
+	return a.Set(f)
+}
+
+// DecodeText lee un DATE en formato texto.
+func (a *Año) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	f, err := decodificarDate(ci, src, false)
+	if err != nil {
+		return err
+	}
+	return a.Set(f)
+}
+
+// EncodeBinary guarda el primer día del período. Si es cero lo guarda como null.
+func (a Año) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := datePeriodo(a)
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeBinary(ci, buf)
+}
+
+// EncodeText guarda el primer día del período. Si es cero lo guarda como null.
+func (a Año) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := datePeriodo(a)
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeText(ci, buf)
+}
+
+// TypeName returns the PostgreSQL name of this type.
+func (Año) TypeName() string {
+	return "date"
+}
+
+func (a *Año) NewTypeValue() pgtype.Value {
+	return new(Año)
+}
+
+// Set acepta un Año, una Fecha, un time.Time o un string con el formato de JSON.
+func (a *Año) Set(src interface{}) (err error) {
+	switch x := src.(type) {
+	case nil:
+		*a = Año{}
+	case Año:
+		*a = x
+	case *Año:
+		*a = Año{}
+		if x != nil {
+			*a = *x
+		}
+	case Fecha:
+		if x.IsInfinite() {
+			return fmt.Errorf("cannot convert infinite date to Año")
+		}
+		if x != 0 && !x.IsValid() {
+			return fmt.Errorf("invalid date '%v'", int(x))
+		}
+		*a = Año{}
+		if x != 0 {
+			*a = x.PeriodoAño()
+		}
+	case time.Time:
+		return a.Set(NewFechaFromTime(x))
+	case string:
+		*a, err = NewAñoFromJSON(x)
+	default:
+		return fmt.Errorf("cannot convert %v to Año", src)
+	}
+	return err
+}
+
+// Get devuelve nil si es cero.
+func (a *Año) Get() interface{} {
+	if a.Zero() {
+		return nil
+	}
+	return *a
+}
+
+// AssignTo acepta *Año, *Fecha, *time.Time o punteros a punteros de
+// ellos. Si es cero, los punteros a punteros quedan en nil.
+func (a *Año) AssignTo(dst interface{}) error {
+	switch x := dst.(type) {
+	case *Año:
+		*x = *a
+	case *Fecha:
+		*x = 0
+		if !a.Zero() {
+			*x = a.PrimerDia()
+		}
+	case *time.Time:
+		if a.Zero() {
+			return fmt.Errorf("cannot assign null Año to %T", dst)
+		}
+		*x = a.PrimerDia().Time()
+	default:
+		if a.Zero() {
+			return pgtype.NullAssignTo(dst)
+		}
+		if siguiente, ok := pgtype.GetAssignToDstType(dst); ok {
+			return a.AssignTo(siguiente)
+		}
+		return fmt.Errorf("cannot assign Año to %T", dst)
+	}
+	return nil
+}
+
+// periodo es cualquiera de los tipos que se persisten como su primer día.
+type periodo interface {
+	Zero() bool
+	Valid() bool
+	PrimerDia() Fecha
+}
+
+// Devuelve el DATE con el primer día del período, o NULL si es cero.
+func datePeriodo(p periodo) (pgtype.Date, error) {
+	if p.Zero() {
+		return pgtype.Date{Status: pgtype.Null}, nil
+	}
+	if !p.Valid() {
+		return pgtype.Date{}, fmt.Errorf("cannot encode invalid period '%v'", p)
+	}
+	return pgtype.Date{Time: p.PrimerDia().Time(), Status: pgtype.Present}, nil
+}
@@ -0,0 +1,98 @@
+package fecha
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodosPgx(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	{ // Binario
+		by, err := Trimestre{2020, 3}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+
+		var tr Trimestre
+		assert.Nil(tr.DecodeBinary(ci, by))
+		assert.Equal(Trimestre{2020, 3}, tr)
+
+		var f Fecha
+		assert.Nil(tr.AssignTo(&f))
+		assert.Equal(Fecha(20200701), f)
+	}
+	{ // Texto
+		by, err := Semestre{2020, 2}.EncodeText(ci, nil)
+		assert.Nil(err)
+		assert.Equal("2020-07-01", string(by))
+
+		var s Semestre
+		assert.Nil(s.DecodeText(ci, by))
+		assert.Equal(Semestre{2020, 2}, s)
+
+		var a Año
+		assert.Nil(a.DecodeText(ci, []byte("2020-08-15")))
+		assert.Equal(Año{2020}, a)
+	}
+	{ // Null
+		by, err := Año{}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		assert.Nil(by)
+
+		a := Año{2020}
+		assert.Nil(a.DecodeBinary(ci, nil))
+		assert.True(a.Zero())
+		assert.Nil(a.Get())
+	}
+	{ // Inválido
+		_, err := Trimestre{2020, 8}.EncodeBinary(ci, nil)
+		assert.NotNil(err)
+
+		var tr Trimestre
+		assert.NotNil(tr.DecodeText(ci, []byte("infinity")))
+
+		// Fechas válidas en PostgreSQL pero fuera de rango
+		assert.NotNil(tr.DecodeText(ci, []byte("0500-01-01")))
+		var s Semestre
+		assert.NotNil(s.DecodeText(ci, []byte("0500-01-01")))
+		var a Año
+		assert.NotNil(a.DecodeText(ci, []byte("0500-01-01")))
+		by, err := pgtype.Date{Time: time.Date(500, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		assert.NotNil(a.DecodeBinary(ci, by))
+		assert.NotNil(a.Set(time.Date(500, 1, 1, 0, 0, 0, 0, time.UTC)))
+	}
+	{ // Punteros a punteros
+		tr := Trimestre{2020, 3}
+		var dst *Trimestre
+		assert.Nil(tr.AssignTo(&dst))
+		assert.Equal(tr, *dst)
+
+		cero := Semestre{}
+		s := &Semestre{2020, 1}
+		assert.Nil(cero.AssignTo(&s))
+		assert.Nil(s)
+
+		var f *Fecha
+		a := Año{2020}
+		assert.Nil(a.AssignTo(&f))
+		assert.Equal(Fecha(20200101), *f)
+		assert.NotNil(a.AssignTo(new(string)))
+	}
+	{ // Set y AssignTo
+		var tr Trimestre
+		assert.Nil(tr.Set(time.Date(2021, 2, 3, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(Trimestre{2021, 1}, tr)
+		assert.Nil(tr.Set("2021-Q4"))
+		assert.Equal(Trimestre{2021, 4}, tr)
+		assert.NotNil(tr.Set(3.5))
+
+		var tm time.Time
+		assert.Nil(tr.AssignTo(&tm))
+		assert.Equal(time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC), tm)
+		assert.NotNil(tr.AssignTo(new(string)))
+	}
+}
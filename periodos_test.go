@@ -0,0 +1,199 @@
+package fecha
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrimestre(t *testing.T) {
+	assert := assert.New(t)
+
+	tr, err := NewTrimestre(2020, 3)
+	assert.Nil(err)
+	assert.Equal(3, tr.TrimestreDelAño())
+	assert.Equal(2020, tr.Año())
+
+	_, err = NewTrimestre(2020, 5)
+	assert.NotNil(err)
+	_, err = NewTrimestre(AñoMaximo+1, 1)
+	assert.NotNil(err)
+	assert.Panics(func() { NewTrimestreMust(2020, 0) })
+
+	assert.False(Trimestre{}.Valid())
+	assert.True(Trimestre{}.Zero())
+	assert.False(Trimestre{2020, 5}.Valid())
+}
+
+func TestPeriodosDesdeFechaYMes(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(Trimestre{2020, 1}, Fecha(20200331).PeriodoTrimestre())
+	assert.Equal(Trimestre{2020, 2}, Fecha(20200401).PeriodoTrimestre())
+	assert.Equal(Trimestre{2020, 4}, Fecha(20201231).PeriodoTrimestre())
+	assert.Equal(Semestre{2020, 1}, Fecha(20200630).PeriodoSemestre())
+	assert.Equal(Semestre{2020, 2}, Fecha(20200701).PeriodoSemestre())
+	assert.Equal(Año{2020}, Fecha(20200701).PeriodoAño())
+
+	for mes := 1; mes <= 12; mes++ {
+		m := NewMesMust(2021, mes)
+		assert.Equal(m.PrimerDia().PeriodoTrimestre(), m.PeriodoTrimestre())
+		assert.Equal(m.PrimerDia().PeriodoSemestre(), m.PeriodoSemestre())
+		assert.Equal(Año{2021}, m.PeriodoAño())
+	}
+}
+
+func TestPrimerYUltimoDiaPeriodos(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(Fecha(20200701), Trimestre{2020, 3}.PrimerDia())
+	assert.Equal(Fecha(20200930), Trimestre{2020, 3}.UltimoDia())
+	assert.Equal(Mes{2020, 7}, Trimestre{2020, 3}.PrimerMes())
+	assert.Equal(Mes{2020, 9}, Trimestre{2020, 3}.UltimoMes())
+	assert.Equal(Fecha(20200101), Semestre{2020, 1}.PrimerDia())
+	assert.Equal(Fecha(20200630), Semestre{2020, 1}.UltimoDia())
+	assert.Equal(Fecha(20201231), Semestre{2020, 2}.UltimoDia())
+	assert.Equal(Fecha(20200101), Año{2020}.PrimerDia())
+	assert.Equal(Fecha(20201231), Año{2020}.UltimoDia())
+}
+
+func TestSumarPeriodos(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(Trimestre{2021, 1}, Trimestre{2020, 4}.SumarTrimestres(1))
+	assert.Equal(Trimestre{2019, 4}, Trimestre{2020, 1}.SumarTrimestres(-1))
+	assert.Equal(Trimestre{2022, 3}, Trimestre{2020, 2}.SumarTrimestres(9))
+	assert.Equal(Trimestre{2020, 2}, Trimestre{2020, 2}.SumarTrimestres(0))
+
+	assert.Equal(Semestre{2021, 1}, Semestre{2020, 2}.SumarSemestres(1))
+	assert.Equal(Semestre{2019, 2}, Semestre{2020, 1}.SumarSemestres(-1))
+	assert.Equal(Semestre{2018, 1}, Semestre{2020, 1}.SumarSemestres(-4))
+
+	assert.Equal(Año{2025}, Año{2020}.SumarAños(5))
+}
+
+func TestCompararPeriodos(t *testing.T) {
+	assert := assert.New(t)
+
+	t1, t2 := Trimestre{2020, 4}, Trimestre{2021, 1}
+	assert.True(t1.Anterior(t2))
+	assert.False(t2.Anterior(t1))
+	assert.True(t1.AnteriorOIgual(t1))
+	assert.True(t2.Posterior(t1))
+	assert.True(t2.PosteriorOIgual(t2))
+	assert.False(t1.Posterior(t1))
+
+	s1, s2 := Semestre{2020, 2}, Semestre{2021, 1}
+	assert.True(s1.Anterior(s2))
+	assert.True(s2.Posterior(s1))
+	assert.True(s1.AnteriorOIgual(s1))
+	assert.True(s1.PosteriorOIgual(s1))
+
+	a1, a2 := Año{2020}, Año{2021}
+	assert.True(a1.Anterior(a2))
+	assert.True(a2.Posterior(a1))
+	assert.True(a1.AnteriorOIgual(a1))
+	assert.True(a1.PosteriorOIgual(a1))
+}
+
+func TestStringPeriodos(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("T3/2020", Trimestre{2020, 3}.String())
+	assert.Equal("S2/2020", Semestre{2020, 2}.String())
+	assert.Equal("2020", Año{2020}.String())
+	assert.Equal("trimestre inválido", Trimestre{}.String())
+	assert.Equal("semestre inválido", Semestre{}.String())
+	assert.Equal("año inválido", Año{}.String())
+
+	assert.Equal("2020-Q3", Trimestre{2020, 3}.JSONString())
+	assert.Equal("2020-S2", Semestre{2020, 2}.JSONString())
+	assert.Equal("2020", Año{2020}.JSONString())
+}
+
+func TestJSONPeriodos(t *testing.T) {
+	assert := assert.New(t)
+
+	type reporte struct {
+		Trimestre Trimestre `json:"trimestre"`
+		Semestre  Semestre  `json:"semestre"`
+		Año       Año       `json:"año"`
+	}
+
+	{
+		r := reporte{Trimestre{2020, 3}, Semestre{2020, 2}, Año{2020}}
+		by, err := json.Marshal(r)
+		assert.Nil(err)
+		assert.Equal(`{"trimestre":"2020-Q3","semestre":"2020-S2","año":"2020"}`, string(by))
+
+		leido := reporte{}
+		err = json.Unmarshal(by, &leido)
+		assert.Nil(err)
+		assert.Equal(r, leido)
+	}
+	{ // Cero
+		by, err := json.Marshal(reporte{})
+		assert.Nil(err)
+		assert.Equal(`{"trimestre":null,"semestre":null,"año":null}`, string(by))
+
+		leido := reporte{Trimestre{2020, 3}, Semestre{2020, 2}, Año{2020}}
+		err = json.Unmarshal([]byte(`{"trimestre":null,"semestre":"","año":null}`), &leido)
+		assert.Nil(err)
+		assert.Equal(reporte{}, leido)
+	}
+	{ // Año como número
+		var a Año
+		assert.Nil(json.Unmarshal([]byte(`2021`), &a))
+		assert.Equal(Año{2021}, a)
+	}
+	{ // Inválidos
+		var tr Trimestre
+		assert.NotNil(json.Unmarshal([]byte(`"2020-Q5"`), &tr))
+		assert.NotNil(json.Unmarshal([]byte(`"2020-3"`), &tr))
+		var s Semestre
+		assert.NotNil(json.Unmarshal([]byte(`"2020-Q1"`), &s))
+		var a Año
+		assert.NotNil(json.Unmarshal([]byte(`"20"`), &a))
+
+		_, err := json.Marshal(Trimestre{2020, 7})
+		assert.NotNil(err)
+	}
+}
+
+func TestSQLPeriodos(t *testing.T) {
+	assert := assert.New(t)
+
+	{
+		v, err := Trimestre{2020, 3}.Value()
+		assert.Nil(err)
+		assert.Equal(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), v)
+
+		v, err = Trimestre{}.Value()
+		assert.Nil(err)
+		assert.Nil(v)
+
+		_, err = Trimestre{2020, 9}.Value()
+		assert.NotNil(err)
+	}
+	{
+		var tr Trimestre
+		assert.Nil(tr.Scan(time.Date(2020, 8, 15, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(Trimestre{2020, 3}, tr)
+		assert.Nil(tr.Scan(nil))
+		assert.True(tr.Zero())
+		assert.NotNil(tr.Scan("2020-08-15"))
+
+		var s Semestre
+		assert.Nil(s.Scan(time.Date(2020, 8, 15, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(Semestre{2020, 2}, s)
+
+		var a Año
+		assert.Nil(a.Scan(time.Date(2020, 8, 15, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(Año{2020}, a)
+		v, err := a.Value()
+		assert.Nil(err)
+		assert.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), v)
+	}
+}
@@ -0,0 +1,460 @@
+package fecha
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgtype"
+)
+
+// Rango es un intervalo cerrado de fechas: incluye tanto Desde como Hasta.
+// Un Rango válido cumple Desde <= Hasta.
+//
+// Al igual que DATERANGE, puede no tener inicio o no tener fin (ver NewRangoDesde
+// y NewRangoHasta) o estar vacío (RangoVacio). El valor cero representa NULL.
+// Un límite abierto no es lo mismo que un límite en MenosInfinito o Infinito:
+// '[2020-08-01,)' y '[2020-08-01,infinity)' son rangos distintos.
+//
+// En JSON se marshaliza con el formato {"desde":"2020-08-01","hasta":"2020-08-31"}
+// (un límite abierto es "unbounded" y el rango vacío es "empty").
+// En la base de datos se persiste como un DATERANGE.
+type Rango struct {
+	Desde Fecha `json:"desde"`
+	Hasta Fecha `json:"hasta"`
+}
+
+// Límites que utiliza Rango para representar un rango sin inicio o sin fin.
+// Son menores y mayores que cualquier fecha, incluso MenosInfinito e Infinito,
+// por lo que las comparaciones funcionan sin casos especiales.
+const (
+	sinInicio Fecha = math.MinInt32
+	sinFin    Fecha = math.MaxInt32
+)
+
+var rangoVacio = Rango{Desde: sinFin, Hasta: sinInicio}
+
+// RangoVacio devuelve el rango que no contiene ningún día ("empty" en
+// DATERANGE). Para saber si un rango está vacío se usa Vacio.
+func RangoVacio() Rango {
+	return rangoVacio
+}
+
+// NewRango crea un rango validando que las fechas sean válidas y que
+// desde no sea posterior a hasta. Desde puede ser MenosInfinito y hasta
+// puede ser Infinito.
+func NewRango(desde, hasta Fecha) (r Rango, err error) {
+	r = Rango{Desde: desde, Hasta: hasta}
+	if !desde.IsValid() && desde != MenosInfinito {
+		return r, fmt.Errorf("invalid date desde '%v'", int(desde))
+	}
+	if !hasta.IsValid() && hasta != Infinito {
+		return r, fmt.Errorf("invalid date hasta '%v'", int(hasta))
+	}
+	if desde > hasta {
+		return r, fmt.Errorf("desde '%v' is after hasta '%v'", desde, hasta)
+	}
+	return r, nil
+}
+
+// NewRangoMust es igual a NewRango pero hace panic si el rango es inválido.
+func NewRangoMust(desde, hasta Fecha) Rango {
+	r, err := NewRango(desde, hasta)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// NewRangoDesde crea un rango sin fin que comienza en desde.
+func NewRangoDesde(desde Fecha) (r Rango, err error) {
+	r = Rango{Desde: desde, Hasta: sinFin}
+	if !desde.IsValid() {
+		return r, fmt.Errorf("invalid date desde '%v'", int(desde))
+	}
+	return r, nil
+}
+
+// NewRangoHasta crea un rango sin inicio que termina en hasta.
+func NewRangoHasta(hasta Fecha) (r Rango, err error) {
+	r = Rango{Desde: sinInicio, Hasta: hasta}
+	if !hasta.IsValid() {
+		return r, fmt.Errorf("invalid date hasta '%v'", int(hasta))
+	}
+	return r, nil
+}
+
+// Valid devuelve true si Desde <= Hasta y cada una es una fecha válida o
+// un límite abierto. RangoVacio también es válido.
+func (r Rango) Valid() bool {
+	if r == rangoVacio {
+		return true
+	}
+	return limiteInferior(r.Desde) && limiteSuperior(r.Hasta) && r.Desde <= r.Hasta
+}
+
+// Devuelven true si la fecha puede ser límite del rango: una fecha válida, el
+// infinito correspondiente o un límite abierto.
+func limiteInferior(f Fecha) bool {
+	return f.IsValid() || f == MenosInfinito || f == sinInicio
+}
+
+func limiteSuperior(f Fecha) bool {
+	return f.IsValid() || f == Infinito || f == sinFin
+}
+
+// SinInicio devuelve true si el rango no tiene límite inferior.
+func (r Rango) SinInicio() bool {
+	return r.Desde == sinInicio
+}
+
+// SinFin devuelve true si el rango no tiene límite superior.
+func (r Rango) SinFin() bool {
+	return r.Hasta == sinFin
+}
+
+// Vacio devuelve true si es el rango vacío (ver RangoVacio).
+func (r Rango) Vacio() bool {
+	return r == rangoVacio
+}
+
+// Devuelve true si el rango no está vacío y sus dos límites son fechas válidas.
+func (r Rango) acotado() bool {
+	return r.Desde.IsValid() && r.Hasta.IsValid() && r.Desde <= r.Hasta
+}
+
+// IsZero devuelve true si las dos fechas son cero.
+func (r Rango) IsZero() bool {
+	return r.Desde.IsZero() && r.Hasta.IsZero()
+}
+
+// Dias devuelve la cantidad de días del rango, incluyendo ambos extremos.
+// Si está vacío devuelve 0 y si no tiene inicio o fin, o alguno de sus
+// límites es infinito, math.MaxInt.
+func (r Rango) Dias() int {
+	switch {
+	case r.Vacio():
+		return 0
+	case !r.Desde.IsValid() || !r.Hasta.IsValid():
+		return math.MaxInt
+	}
+	return Diff(r.Desde, r.Hasta) + 1
+}
+
+// Contiene devuelve true si la fecha está dentro del rango.
+func (r Rango) Contiene(f Fecha) bool {
+	return r.Desde <= f && f <= r.Hasta
+}
+
+// ContieneRango devuelve true si r2 está completamente dentro del rango.
+func (r Rango) ContieneRango(r2 Rango) bool {
+	return r.Desde <= r2.Desde && r2.Hasta <= r.Hasta
+}
+
+// Superpone devuelve true si los rangos tienen al menos un día en común.
+func (r Rango) Superpone(r2 Rango) bool {
+	if r.Vacio() || r2.Vacio() {
+		return false
+	}
+	return r.Desde <= r2.Hasta && r2.Desde <= r.Hasta
+}
+
+// Interseccion devuelve los días en común entre los dos rangos.
+// Si no se superponen devuelve false.
+func (r Rango) Interseccion(r2 Rango) (out Rango, ok bool) {
+	if !r.Superpone(r2) {
+		return out, false
+	}
+	out.Desde = r.Desde
+	if r2.Desde > out.Desde {
+		out.Desde = r2.Desde
+	}
+	out.Hasta = r.Hasta
+	if r2.Hasta < out.Hasta {
+		out.Hasta = r2.Hasta
+	}
+	return out, true
+}
+
+// Union devuelve el rango que abarca a ambos.
+// Devuelve error si entre los rangos hay días que no pertenecen a ninguno.
+func (r Rango) Union(r2 Rango) (out Rango, err error) {
+	switch {
+	case r.Vacio():
+		return r2, nil
+	case r2.Vacio():
+		return r, nil
+	}
+	if !r.Superpone(r2) && !r.Contiguo(r2) {
+		return out, fmt.Errorf("ranges %v and %v are disjoint", r, r2)
+	}
+	out.Desde = r.Desde
+	if r2.Desde < out.Desde {
+		out.Desde = r2.Desde
+	}
+	out.Hasta = r.Hasta
+	if r2.Hasta > out.Hasta {
+		out.Hasta = r2.Hasta
+	}
+	return out, nil
+}
+
+// Contiguo devuelve true si uno de los rangos comienza el día siguiente
+// a que termina el otro.
+func (r Rango) Contiguo(r2 Rango) bool {
+	if r.Vacio() || r2.Vacio() {
+		return false
+	}
+	return !r.SinFin() && !r2.SinInicio() && r.Hasta.AgregarDias(1) == r2.Desde ||
+		!r2.SinFin() && !r.SinInicio() && r2.Hasta.AgregarDias(1) == r.Desde
+}
+
+// Hueco devuelve los días que quedan entre los dos rangos.
+// Si se superponen o son contiguos devuelve false.
+func (r Rango) Hueco(r2 Rango) (out Rango, ok bool) {
+	if r.Vacio() || r2.Vacio() || r.Superpone(r2) || r.Contiguo(r2) {
+		return out, false
+	}
+	if r.Hasta < r2.Desde {
+		return Rango{Desde: r.Hasta.AgregarDias(1), Hasta: r2.Desde.AgregarDias(-1)}, true
+	}
+	return Rango{Desde: r2.Hasta.AgregarDias(1), Hasta: r.Desde.AgregarDias(-1)}, true
+}
+
+// Unir devuelve los rangos ordenados, uniendo los que se superponen
+// o son contiguos. Los rangos vacíos se descartan.
+func Unir(rangos ...Rango) (out []Rango) {
+	ordenados := make([]Rango, 0, len(rangos))
+	for _, v := range rangos {
+		if !v.Vacio() {
+			ordenados = append(ordenados, v)
+		}
+	}
+	if len(ordenados) == 0 {
+		return nil
+	}
+	sort.Slice(ordenados, func(i, j int) bool {
+		return ordenados[i].Desde < ordenados[j].Desde
+	})
+
+	actual := ordenados[0]
+	for _, v := range ordenados[1:] {
+		unido, err := actual.Union(v)
+		if err != nil {
+			out = append(out, actual)
+			actual = v
+			continue
+		}
+		actual = unido
+	}
+	return append(out, actual)
+}
+
+// PorMes divide el rango en un rango por cada mes calendario que abarca.
+// El primero y el último pueden ser meses incompletos.
+// Si el rango está vacío, no tiene inicio o fin, o alguno de sus límites es
+// infinito devuelve nil.
+func (r Rango) PorMes() (out []Rango) {
+	if !r.acotado() {
+		return nil
+	}
+	desde := r.Desde
+	for desde <= r.Hasta {
+		hasta := desde.PeriodoMes().UltimoDia()
+		if hasta > r.Hasta {
+			hasta = r.Hasta
+		}
+		out = append(out, Rango{Desde: desde, Hasta: hasta})
+		desde = hasta.AgregarDias(1)
+	}
+	return out
+}
+
+// Recorrer llama a la función con cada día del rango, en orden.
+// Si la función devuelve false se detiene la iteración.
+// Recorre los mismos días que Iterador.
+func (r Rango) Recorrer(fn func(Fecha) bool) {
+	for it := r.Iterador(); it.Siguiente(); {
+		if !fn(it.Fecha()) {
+			return
+		}
+	}
+}
+
+// String devuelve el rango con el formato "01/08/2020 - 31/08/2020".
+// Los límites abiertos se muestran como "-∞" y "∞".
+func (r Rango) String() string {
+	if r.Vacio() {
+		return "vacío"
+	}
+	desde, hasta := r.Desde.String(), r.Hasta.String()
+	if r.SinInicio() {
+		desde = "-∞"
+	}
+	if r.SinFin() {
+		hasta = "∞"
+	}
+	return desde + " - " + hasta
+}
+
+// Marcador JSON de un límite abierto. No es "infinity" ni "-infinity", que
+// son límites distintos.
+const jsonSinLimite = "unbounded"
+
+// MarshalJSON marshaliza el rango. Si es cero devuelve null.
+// Los límites abiertos se marshalizan como "unbounded" y el rango vacío como
+// "empty".
+func (r Rango) MarshalJSON() (by []byte, err error) {
+	if r.IsZero() {
+		return []byte("null"), nil
+	}
+	if !r.Valid() {
+		return by, fmt.Errorf("invalid range '%v'", r)
+	}
+	if r.Vacio() {
+		return []byte(`"empty"`), nil
+	}
+	by = append(by, `{"desde":`...)
+	by, err = appendLimiteJSON(by, r.Desde, r.SinInicio())
+	if err != nil {
+		return nil, err
+	}
+	by = append(by, `,"hasta":`...)
+	by, err = appendLimiteJSON(by, r.Hasta, r.SinFin())
+	if err != nil {
+		return nil, err
+	}
+	return append(by, '}'), nil
+}
+
+func appendLimiteJSON(by []byte, f Fecha, abierto bool) ([]byte, error) {
+	if abierto {
+		return append(by, `"`+jsonSinLimite+`"`...), nil
+	}
+	limite, err := f.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return append(by, limite...), nil
+}
+
+// UnmarshalJSON parsea el rango validando que Desde <= Hasta.
+// Si llega null se crea un rango con valor cero y "empty" devuelve el rango
+// vacío. Los dos límites son obligatorios: un límite abierto se indica con
+// "unbounded", y un límite ausente o null devuelve error.
+func (r *Rango) UnmarshalJSON(input []byte) error {
+	switch string(input) {
+	case "null":
+		*r = Rango{}
+		return nil
+	case `"empty"`:
+		*r = rangoVacio
+		return nil
+	}
+	limites := struct {
+		Desde json.RawMessage `json:"desde"`
+		Hasta json.RawMessage `json:"hasta"`
+	}{}
+	err := json.Unmarshal(input, &limites)
+	if err != nil {
+		return err
+	}
+	nuevo := Rango{}
+	nuevo.Desde, err = limiteDesdeJSON("desde", limites.Desde, sinInicio)
+	if err != nil {
+		return err
+	}
+	nuevo.Hasta, err = limiteDesdeJSON("hasta", limites.Hasta, sinFin)
+	if err != nil {
+		return err
+	}
+	if !nuevo.Valid() {
+		return fmt.Errorf("invalid range '%v'", nuevo)
+	}
+	*r = nuevo
+	return nil
+}
+
+// Parsea un límite del rango. "unbounded" devuelve abierto.
+func limiteDesdeJSON(nombre string, input json.RawMessage, abierto Fecha) (Fecha, error) {
+	switch string(input) {
+	case "", "null":
+		return 0, fmt.Errorf("missing %v (use \"%v\" for an open end)", nombre, jsonSinLimite)
+	case `"` + jsonSinLimite + `"`:
+		return abierto, nil
+	}
+	var f Fecha
+	err := f.UnmarshalJSON(input)
+	if err != nil {
+		return 0, err
+	}
+	if f == 0 {
+		return 0, fmt.Errorf("missing %v (use \"%v\" for an open end)", nombre, jsonSinLimite)
+	}
+	return f, nil
+}
+
+var _ driver.Valuer = Rango{}
+
+// Value satisface la interface de package sql.
+// Lo persiste con el formato canónico de DATERANGE: [2020-08-01,2020-09-01),
+// [2020-08-01,) si no tiene fin o empty si está vacío.
+// Si el rango es cero lo guarda como null.
+func (r Rango) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+	d, err := r.daterange()
+	if err != nil {
+		return nil, err
+	}
+	by, err := d.EncodeText(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(by), nil
+}
+
+var _ sql.Scanner = (*Rango)(nil)
+
+// Scan satisface la interface de package sql.
+// Acepta el texto de un DATERANGE con límites inclusivos o exclusivos.
+func (r *Rango) Scan(value interface{}) error {
+	var texto string
+	switch v := value.(type) {
+	case nil:
+		*r = Rango{}
+		return nil
+	case string:
+		texto = v
+	case []byte:
+		texto = string(v)
+	default:
+		return fmt.Errorf("expected value type: string, got: %T", value)
+	}
+
+	nuevo, err := NewRangoFromString(texto)
+	if err != nil {
+		return err
+	}
+	*r = nuevo
+	return nil
+}
+
+// NewRangoFromString parsea un rango con el formato de DATERANGE de PostgreSQL.
+// Los límites pueden ser inclusivos "[" "]" o exclusivos "(" ")", y pueden
+// faltar para indicar un rango sin inicio o sin fin.
+// Por ejemplo: [2020-08-01,2020-09-01) o [2020-08-01,)
+// El rango "empty" devuelve RangoVacio.
+func NewRangoFromString(texto string) (r Rango, err error) {
+	d := pgtype.Daterange{}
+	err = d.DecodeText(nil, []byte(strings.TrimSpace(texto)))
+	if err != nil {
+		return r, fmt.Errorf("incorrect range format '%v': %w", texto, err)
+	}
+	return rangoDesdeDaterange(d)
+}
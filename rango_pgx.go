@@ -0,0 +1,226 @@
+package fecha
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgtype"
+)
+
+var _ pgtype.ValueTranscoder = (*Rango)(nil)
+var _ pgtype.Value = (*Rango)(nil)
+var _ pgtype.TypeValue = (*Rango)(nil)
+
+// DecodeBinary lee un DATERANGE en formato binario. NULL se lee como rango cero.
+func (t *Rango) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	d := pgtype.Daterange{}
+	err := d.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+	return t.Set(d)
+}
+
+// EncodeBinary guarda el rango como DATERANGE. El rango cero se guarda como NULL.
+func (src Rango) EncodeBinary(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := src.daterange()
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeBinary(ci, buf)
+}
+
+// DecodeText lee un DATERANGE en formato texto ("[2020-08-01,2020-09-01)").
+// NULL se lee como rango cero.
+func (t *Rango) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	d := pgtype.Daterange{}
+	err := d.DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+	return t.Set(d)
+}
+
+// EncodeText guarda el rango con el formato canónico "[2020-08-01,2020-09-01)".
+// El rango cero se guarda como NULL.
+func (src Rango) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	d, err := src.daterange()
+	if err != nil {
+		return nil, err
+	}
+	return d.EncodeText(ci, buf)
+}
+
+// TypeName returns the PostgreSQL name of this type.
+func (Rango) TypeName() string {
+	return "daterange"
+}
+
+func (t *Rango) NewTypeValue() pgtype.Value {
+	return new(Rango)
+}
+
+// Set acepta un Rango, un pgtype.Daterange o un string con el formato de
+// DATERANGE (y punteros a ellos). nil se toma como rango cero.
+func (t *Rango) Set(src interface{}) error {
+	var nuevo Rango
+	var err error
+	switch v := src.(type) {
+	case nil:
+	case Rango:
+		nuevo = v
+	case *Rango:
+		if v != nil {
+			nuevo = *v
+		}
+	case pgtype.Daterange:
+		nuevo, err = rangoDesdeDaterange(v)
+	case string:
+		nuevo, err = NewRangoFromString(v)
+	case *string:
+		if v != nil {
+			nuevo, err = NewRangoFromString(*v)
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to Rango", src)
+	}
+	if err != nil {
+		return err
+	}
+	*t = nuevo
+	return nil
+}
+
+// Get devuelve el rango, o nil si es cero.
+func (t *Rango) Get() interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return *t
+}
+
+// AssignTo asigna el rango a *Rango, *pgtype.Daterange, *string (formato
+// canónico de DATERANGE) o punteros a punteros de ellos. Si el rango es cero,
+// los punteros a punteros quedan en nil y *string devuelve error.
+func (t *Rango) AssignTo(dst interface{}) error {
+	switch v := dst.(type) {
+	case *Rango:
+		*v = *t
+		return nil
+	case *pgtype.Daterange:
+		d, err := t.daterange()
+		if err != nil {
+			return err
+		}
+		*v = d
+		return nil
+	}
+
+	if t.IsZero() {
+		return pgtype.NullAssignTo(dst)
+	}
+
+	switch v := dst.(type) {
+	case *string:
+		d, err := t.daterange()
+		if err != nil {
+			return err
+		}
+		by, err := d.EncodeText(nil, nil)
+		if err != nil {
+			return err
+		}
+		*v = string(by)
+	default:
+		if siguiente, ok := pgtype.GetAssignToDstType(dst); ok {
+			return t.AssignTo(siguiente)
+		}
+		return fmt.Errorf("unable to assign to %T", dst)
+	}
+	return nil
+}
+
+// Devuelve el rango como pgtype.Daterange, con el límite inferior inclusivo y
+// el superior exclusivo como lo normaliza PostgreSQL. El rango cero es NULL.
+// MenosInfinito e Infinito se guardan como '-infinity' e 'infinity'.
+func (r Rango) daterange() (d pgtype.Daterange, err error) {
+	if r.IsZero() {
+		return pgtype.Daterange{Status: pgtype.Null}, nil
+	}
+	if !r.Valid() {
+		return d, fmt.Errorf("invalid range '%v'", r)
+	}
+	d.Status = pgtype.Present
+	if r.Vacio() {
+		d.LowerType, d.UpperType = pgtype.Empty, pgtype.Empty
+		return d, nil
+	}
+
+	d.LowerType = pgtype.Unbounded
+	if !r.SinInicio() {
+		d.LowerType = pgtype.Inclusive
+		d.Lower, err = r.Desde.date()
+		if err != nil {
+			return pgtype.Daterange{}, err
+		}
+	}
+	d.UpperType = pgtype.Unbounded
+	switch {
+	case r.Hasta == Infinito:
+		d.UpperType = pgtype.Exclusive
+		d.Upper, err = r.Hasta.date()
+		if err != nil {
+			return pgtype.Daterange{}, err
+		}
+	case !r.SinFin():
+		d.UpperType = pgtype.Exclusive
+		d.Upper = pgtype.Date{Time: r.Hasta.Time().AddDate(0, 0, 1), Status: pgtype.Present}
+	}
+	return d, nil
+}
+
+// Convierte un pgtype.Daterange en Rango, pasando los límites exclusivos a
+// inclusivos. NULL se devuelve como rango cero y un rango que no contiene
+// ningún día, como RangoVacio.
+func rangoDesdeDaterange(d pgtype.Daterange) (r Rango, err error) {
+	switch d.Status {
+	case pgtype.Null, pgtype.Undefined:
+		return r, nil
+	}
+	if d.LowerType == pgtype.Empty || d.UpperType == pgtype.Empty {
+		return rangoVacio, nil
+	}
+
+	r = Rango{Desde: sinInicio, Hasta: sinFin}
+	if d.LowerType != pgtype.Unbounded {
+		r.Desde, err = fechaDesdeDate(d.Lower)
+		if err != nil {
+			return Rango{}, err
+		}
+	}
+	if d.UpperType != pgtype.Unbounded {
+		r.Hasta, err = fechaDesdeDate(d.Upper)
+		if err != nil {
+			return Rango{}, err
+		}
+	}
+	if r.Desde == 0 || r.Hasta == 0 {
+		return Rango{}, fmt.Errorf("range bound cannot be null")
+	}
+	if !limiteInferior(r.Desde) || !limiteSuperior(r.Hasta) {
+		return Rango{}, fmt.Errorf("invalid range bounds '%v' and '%v'", int(r.Desde), int(r.Hasta))
+	}
+	if r.Desde > r.Hasta {
+		return Rango{}, fmt.Errorf("range lower bound '%v' must be less than or equal to range upper bound '%v'", r.Desde, r.Hasta)
+	}
+
+	if d.LowerType == pgtype.Exclusive {
+		r.Desde = r.Desde.AgregarDias(1)
+	}
+	if d.UpperType == pgtype.Exclusive {
+		r.Hasta = r.Hasta.AgregarDias(-1)
+	}
+	if r.Desde > r.Hasta {
+		return rangoVacio, nil
+	}
+	return r, nil
+}
@@ -0,0 +1,152 @@
+package fecha
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangoPgxBinario(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	for _, v := range []Rango{
+		{20200801, 20200831},
+		{20200801, sinFin},
+		{sinInicio, 20200831},
+		{sinInicio, sinFin},
+		{20200801, Infinito},
+		{MenosInfinito, 20200831},
+		{MenosInfinito, sinFin},
+		RangoVacio(),
+	} {
+		by, err := v.EncodeBinary(ci, nil)
+		assert.Nil(err, "%v", v)
+
+		var r Rango
+		assert.Nil(r.DecodeBinary(ci, by), "%v", v)
+		assert.Equal(v, r)
+	}
+
+	{ // Límites exclusivos
+		by, err := pgtype.Daterange{
+			Lower:     pgtype.Date{Time: time.Date(2020, 7, 31, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+			Upper:     pgtype.Date{Time: time.Date(2020, 8, 31, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+			LowerType: pgtype.Exclusive,
+			UpperType: pgtype.Inclusive,
+			Status:    pgtype.Present,
+		}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+
+		var r Rango
+		assert.Nil(r.DecodeBinary(ci, by))
+		assert.Equal(Rango{20200801, 20200831}, r)
+	}
+	{ // NULL
+		by, err := Rango{}.EncodeBinary(ci, nil)
+		assert.Nil(err)
+		assert.Nil(by)
+
+		r := Rango{20200801, 20200831}
+		assert.Nil(r.DecodeBinary(ci, nil))
+		assert.Equal(Rango{}, r)
+	}
+	_, err := Rango{20200831, 20200801}.EncodeBinary(ci, nil)
+	assert.NotNil(err)
+}
+
+func TestRangoPgxTexto(t *testing.T) {
+	assert := assert.New(t)
+	ci := pgtype.NewConnInfo()
+
+	by, err := Rango{20200801, 20200831}.EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Equal("[2020-08-01,2020-09-01)", string(by))
+
+	by, err = RangoVacio().EncodeText(ci, nil)
+	assert.Nil(err)
+	assert.Equal("empty", string(by))
+
+	for texto, esperado := range map[string]Rango{
+		"[2020-08-01,2020-09-01)": {20200801, 20200831},
+		"[2020-08-01,2020-08-31]": {20200801, 20200831},
+		"(2020-07-31,2020-09-01)": {20200801, 20200831},
+		"[2020-08-01,)":           {20200801, sinFin},
+		"(,2020-08-31]":           {sinInicio, 20200831},
+		"(,)":                     {sinInicio, sinFin},
+		"empty":                   RangoVacio(),
+	} {
+		var r Rango
+		assert.Nil(r.DecodeText(ci, []byte(texto)), texto)
+		assert.Equal(esperado, r, texto)
+	}
+
+	r := Rango{20200801, 20200831}
+	assert.Nil(r.DecodeText(ci, nil))
+	assert.Equal(Rango{}, r)
+
+	for _, v := range []string{"2020-08-01", "[2020-09-01,2020-08-01)", "[infinity,infinity)", "[2020-08-01,-infinity)"} {
+		assert.NotNil(r.DecodeText(ci, []byte(v)), v)
+	}
+
+	// Los límites infinitos no son límites abiertos y se mantienen
+	for texto, esperado := range map[string]Rango{
+		"[-infinity,2020-09-01)": {MenosInfinito, 20200831},
+		"[2020-08-01,infinity)":  {20200801, Infinito},
+		"[-infinity,infinity)":   {MenosInfinito, Infinito},
+		"[-infinity,)":           {MenosInfinito, sinFin},
+	} {
+		assert.Nil(r.DecodeText(ci, []byte(texto)), texto)
+		assert.Equal(esperado, r, texto)
+
+		by, err := r.EncodeText(ci, nil)
+		assert.Nil(err, texto)
+		assert.Equal(texto, string(by))
+	}
+	assert.Nil(r.DecodeText(ci, []byte("[2020-08-01,infinity)")))
+	assert.False(r.SinFin())
+	assert.True(r.Contiene(99991231))
+}
+
+func TestRangoPgxSetYAssignTo(t *testing.T) {
+	assert := assert.New(t)
+
+	texto := "[2020-08-01,2020-09-01)"
+	otro := Rango{20200801, 20200831}
+	for _, v := range []interface{}{otro, &otro, texto, &texto} {
+		var r Rango
+		assert.Nil(r.Set(v), "%T", v)
+		assert.Equal(otro, r, "%T", v)
+	}
+	for _, v := range []interface{}{nil, (*Rango)(nil), (*string)(nil), pgtype.Daterange{Status: pgtype.Null}} {
+		r := otro
+		assert.Nil(r.Set(v), "%T", v)
+		assert.Equal(Rango{}, r, "%T", v)
+	}
+	assert.NotNil(new(Rango).Set(3))
+	assert.Equal(otro, otro.Get())
+	assert.Nil(new(Rango).Get())
+
+	{
+		var dst pgtype.Daterange
+		assert.Nil(otro.AssignTo(&dst))
+		assert.Equal(pgtype.Inclusive, dst.LowerType)
+		assert.Equal(pgtype.Exclusive, dst.UpperType)
+		assert.Equal(time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC), dst.Upper.Time)
+	}
+	{
+		var dst *string
+		assert.Nil(otro.AssignTo(&dst))
+		assert.Equal(texto, *dst)
+	}
+	{ // NULL
+		cero := Rango{}
+		dst := &texto
+		assert.Nil(cero.AssignTo(&dst))
+		assert.Nil(dst)
+		assert.NotNil(cero.AssignTo(new(string)))
+	}
+	assert.NotNil(otro.AssignTo(new(int)))
+}
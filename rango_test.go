@@ -0,0 +1,344 @@
+package fecha
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRango(t *testing.T) {
+	assert := assert.New(t)
+	{
+		r, err := NewRango(20200801, 20200831)
+		assert.Nil(err)
+		assert.Equal(Rango{20200801, 20200831}, r)
+		assert.Equal(31, r.Dias())
+	}
+	{ // Un solo día
+		r, err := NewRango(20200801, 20200801)
+		assert.Nil(err)
+		assert.Equal(1, r.Dias())
+	}
+	{ // Invertido
+		_, err := NewRango(20200831, 20200801)
+		assert.NotNil(err)
+	}
+	{ // Fecha inválida
+		_, err := NewRango(20200801, 20200231)
+		assert.NotNil(err)
+	}
+}
+
+func TestRangoContieneYSuperpone(t *testing.T) {
+	assert := assert.New(t)
+	r := Rango{20200801, 20200831}
+
+	assert.True(r.Contiene(20200801))
+	assert.True(r.Contiene(20200831))
+	assert.False(r.Contiene(20200731))
+	assert.False(r.Contiene(20200901))
+
+	assert.True(r.ContieneRango(Rango{20200810, 20200820}))
+	assert.False(r.ContieneRango(Rango{20200810, 20200901}))
+
+	assert.True(r.Superpone(Rango{20200831, 20200910}))
+	assert.True(r.Superpone(Rango{20200701, 20200801}))
+	assert.False(r.Superpone(Rango{20200901, 20200910}))
+}
+
+func TestRangoInterseccion(t *testing.T) {
+	assert := assert.New(t)
+	r := Rango{20200801, 20200831}
+
+	i, ok := r.Interseccion(Rango{20200815, 20200915})
+	assert.True(ok)
+	assert.Equal(Rango{20200815, 20200831}, i)
+
+	_, ok = r.Interseccion(Rango{20200901, 20200915})
+	assert.False(ok)
+}
+
+func TestRangoUnionYHueco(t *testing.T) {
+	assert := assert.New(t)
+	r := Rango{20200801, 20200831}
+
+	{ // Contiguos
+		u, err := r.Union(Rango{20200901, 20200930})
+		assert.Nil(err)
+		assert.Equal(Rango{20200801, 20200930}, u)
+
+		_, ok := r.Hueco(Rango{20200901, 20200930})
+		assert.False(ok)
+	}
+	{ // Superpuestos
+		u, err := r.Union(Rango{20200715, 20200810})
+		assert.Nil(err)
+		assert.Equal(Rango{20200715, 20200831}, u)
+	}
+	{ // Separados
+		_, err := r.Union(Rango{20200905, 20200930})
+		assert.NotNil(err)
+
+		h, ok := r.Hueco(Rango{20200905, 20200930})
+		assert.True(ok)
+		assert.Equal(Rango{20200901, 20200904}, h)
+
+		h, ok = Rango{20200905, 20200930}.Hueco(r)
+		assert.True(ok)
+		assert.Equal(Rango{20200901, 20200904}, h)
+	}
+}
+
+func TestUnir(t *testing.T) {
+	assert := assert.New(t)
+	assert.Nil(Unir())
+
+	out := Unir(
+		Rango{20200910, 20200920},
+		Rango{20200801, 20200815},
+		Rango{20200816, 20200831},
+		Rango{20200915, 20200930},
+		Rango{20201101, 20201130},
+	)
+	assert.Equal([]Rango{
+		{20200801, 20200831},
+		{20200910, 20200930},
+		{20201101, 20201130},
+	}, out)
+}
+
+func TestRangoPorMes(t *testing.T) {
+	assert := assert.New(t)
+	r := Rango{20200815, 20201010}
+	assert.Equal([]Rango{
+		{20200815, 20200831},
+		{20200901, 20200930},
+		{20201001, 20201010},
+	}, r.PorMes())
+
+	assert.Equal([]Rango{{20200803, 20200805}}, Rango{20200803, 20200805}.PorMes())
+}
+
+func TestRangoRecorrer(t *testing.T) {
+	assert := assert.New(t)
+
+	fechas := []Fecha{}
+	Rango{20201230, 20210102}.Recorrer(func(f Fecha) bool {
+		fechas = append(fechas, f)
+		return true
+	})
+	assert.Equal([]Fecha{20201230, 20201231, 20210101, 20210102}, fechas)
+
+	fechas = nil
+	Rango{20201230, 20210102}.Recorrer(func(f Fecha) bool {
+		fechas = append(fechas, f)
+		return len(fechas) < 2
+	})
+	assert.Equal([]Fecha{20201230, 20201231}, fechas)
+}
+
+func TestRangoJSON(t *testing.T) {
+	assert := assert.New(t)
+	{
+		by, err := json.Marshal(Rango{20200801, 20200831})
+		assert.Nil(err)
+		assert.Equal(`{"desde":"2020-08-01","hasta":"2020-08-31"}`, string(by))
+
+		r := Rango{}
+		err = json.Unmarshal(by, &r)
+		assert.Nil(err)
+		assert.Equal(Rango{20200801, 20200831}, r)
+	}
+	{ // Cero
+		by, err := json.Marshal(Rango{})
+		assert.Nil(err)
+		assert.Equal("null", string(by))
+	}
+	{ // Invertido
+		r := Rango{}
+		err := json.Unmarshal([]byte(`{"desde":"2020-08-31","hasta":"2020-08-01"}`), &r)
+		assert.NotNil(err)
+	}
+	{ // Sin fin
+		by, err := json.Marshal(Rango{20200801, sinFin})
+		assert.Nil(err)
+		assert.Equal(`{"desde":"2020-08-01","hasta":"unbounded"}`, string(by))
+
+		r := Rango{}
+		assert.Nil(json.Unmarshal(by, &r))
+		assert.Equal(Rango{20200801, sinFin}, r)
+	}
+	{ // Sin inicio y hasta infinito
+		by, err := json.Marshal(Rango{sinInicio, Infinito})
+		assert.Nil(err)
+		assert.Equal(`{"desde":"unbounded","hasta":"infinity"}`, string(by))
+
+		r := Rango{}
+		assert.Nil(json.Unmarshal(by, &r))
+		assert.Equal(Rango{sinInicio, Infinito}, r)
+	}
+	{ // Los límites son obligatorios
+		r := Rango{20200801, 20200831}
+		for _, v := range []string{
+			`{}`,
+			`{"desde":"2020-08-01"}`,
+			`{"hasta":"2020-08-31"}`,
+			`{"desde":null,"hasta":"2020-08-31"}`,
+			`{"desde":"2020-08-01","hasta":null}`,
+			`{"desde":"","hasta":"2020-08-31"}`,
+		} {
+			assert.NotNil(json.Unmarshal([]byte(v), &r), v)
+		}
+		assert.Equal(Rango{20200801, 20200831}, r)
+	}
+	{ // Vacío
+		by, err := json.Marshal(RangoVacio())
+		assert.Nil(err)
+		assert.Equal(`"empty"`, string(by))
+
+		r := Rango{}
+		assert.Nil(json.Unmarshal(by, &r))
+		assert.Equal(RangoVacio(), r)
+	}
+}
+
+func TestRangoSQL(t *testing.T) {
+	assert := assert.New(t)
+	{
+		v, err := Rango{20200801, 20200831}.Value()
+		assert.Nil(err)
+		assert.Equal("[2020-08-01,2020-09-01)", v)
+	}
+	{
+		v, err := Rango{}.Value()
+		assert.Nil(err)
+		assert.Nil(v)
+	}
+	{
+		r := Rango{}
+		assert.Nil(r.Scan("[2020-08-01,2020-09-01)"))
+		assert.Equal(Rango{20200801, 20200831}, r)
+
+		assert.Nil(r.Scan([]byte("(2020-07-31,2020-08-31]")))
+		assert.Equal(Rango{20200801, 20200831}, r)
+
+		assert.Nil(r.Scan(nil))
+		assert.Equal(Rango{}, r)
+
+		assert.Nil(r.Scan("[2020-08-01,)"))
+		assert.Equal(Rango{20200801, sinFin}, r)
+
+		assert.Nil(r.Scan("(,2020-09-01)"))
+		assert.Equal(Rango{sinInicio, 20200831}, r)
+
+		assert.Nil(r.Scan("empty"))
+		assert.Equal(RangoVacio(), r)
+
+		assert.Nil(r.Scan("(2020-08-01,2020-08-02)"))
+		assert.Equal(RangoVacio(), r)
+
+		assert.NotNil(r.Scan("2020-08-01"))
+		assert.NotNil(r.Scan("[2020-09-01,2020-08-01)"))
+		assert.Equal(RangoVacio(), r)
+	}
+	{ // Sin límites y vacío
+		r, err := NewRangoDesde(20200801)
+		assert.Nil(err)
+		v, err := r.Value()
+		assert.Nil(err)
+		assert.Equal("[2020-08-01,)", v)
+
+		r, err = NewRangoHasta(20200831)
+		assert.Nil(err)
+		v, err = r.Value()
+		assert.Nil(err)
+		assert.Equal("(,2020-09-01)", v)
+
+		v, err = RangoVacio().Value()
+		assert.Nil(err)
+		assert.Equal("empty", v)
+
+		_, err = Rango{20200831, 20200801}.Value()
+		assert.NotNil(err)
+	}
+}
+
+func TestRangoSinLimites(t *testing.T) {
+	assert := assert.New(t)
+
+	desde, err := NewRangoDesde(20200801)
+	assert.Nil(err)
+	assert.True(desde.Valid())
+	assert.True(desde.SinFin())
+	assert.False(desde.SinInicio())
+	assert.True(desde.Contiene(99991231))
+	assert.False(desde.Contiene(20200731))
+	assert.Equal("01/08/2020 - ∞", desde.String())
+
+	hasta, err := NewRangoHasta(20200731)
+	assert.Nil(err)
+	assert.True(hasta.SinInicio())
+	assert.Equal("-∞ - 31/07/2020", hasta.String())
+
+	assert.False(desde.Superpone(hasta))
+	assert.True(desde.Contiguo(hasta))
+	u, err := desde.Union(hasta)
+	assert.Nil(err)
+	assert.Equal(Rango{sinInicio, sinFin}, u)
+
+	i, ok := desde.Interseccion(NewRangoMust(20200101, 20200815))
+	assert.True(ok)
+	assert.Equal(NewRangoMust(20200801, 20200815), i)
+
+	assert.Nil(desde.PorMes())
+	assert.Equal(0, RangoVacio().Dias())
+
+	_, err = NewRangoDesde(20200231)
+	assert.NotNil(err)
+	_, err = NewRangoHasta(0)
+	assert.NotNil(err)
+
+	// Un límite en Infinito no es un límite abierto
+	infinito, err := NewRango(20200801, Infinito)
+	assert.Nil(err)
+	assert.True(infinito.Valid())
+	assert.False(infinito.SinFin())
+	assert.True(infinito.Contiene(99991231))
+	assert.Equal(math.MaxInt, infinito.Dias())
+	assert.Nil(infinito.PorMes())
+	assert.Equal("01/08/2020 - infinito", infinito.String())
+	u, err = infinito.Union(desde)
+	assert.Nil(err)
+	assert.Equal(desde, u)
+	_, err = NewRango(Infinito, Infinito)
+	assert.NotNil(err)
+
+	n := 0
+	infinito.Recorrer(func(Fecha) bool {
+		n++
+		return n < 10
+	})
+	assert.Equal(10, n)
+}
+
+func TestRangoVacio(t *testing.T) {
+	assert := assert.New(t)
+	r := NewRangoMust(20200801, 20200831)
+
+	assert.True(RangoVacio().Valid())
+	assert.True(RangoVacio().Vacio())
+	assert.False(RangoVacio().IsZero())
+	assert.False(RangoVacio().Contiene(20200801))
+	assert.False(r.Superpone(RangoVacio()))
+	assert.False(r.Contiguo(RangoVacio()))
+	_, ok := r.Hueco(RangoVacio())
+	assert.False(ok)
+
+	u, err := r.Union(RangoVacio())
+	assert.Nil(err)
+	assert.Equal(r, u)
+	assert.Equal([]Rango{r}, Unir(RangoVacio(), r))
+	assert.Equal("vacío", RangoVacio().String())
+}
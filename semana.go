@@ -0,0 +1,239 @@
+package fecha
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Semana representa una semana según ISO 8601: comienza el lunes y la
+// semana 1 es la que contiene el primer jueves del año.
+//
+// El año de la semana no siempre coincide con el de la fecha: el 31/12/2024
+// pertenece a la semana 2025-W01 y el 01/01/2021 a la semana 2020-W53.
+//
+// En JSON se marshaliza con el formato "2020-W34".
+type Semana struct {
+	año    int
+	semana int
+}
+
+var NilSemana = Semana{}
+
+// NewSemana crea una semana validando que el año tenga esa cantidad de semanas.
+func NewSemana(año, semana int) (Semana, error) {
+	s := Semana{año, semana}
+	if err := validarAño(año); err != nil {
+		return s, err
+	}
+	if semana < 1 || semana > SemanasDelAño(año) {
+		return s, fmt.Errorf("invalid week '%v' (year %v has %v weeks)", semana, año, SemanasDelAño(año))
+	}
+	return s, nil
+}
+
+func NewSemanaMust(año, semana int) Semana {
+	s, err := NewSemana(año, semana)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewSemanaFromJSON parsea una semana con el formato "2020-W34".
+func NewSemanaFromJSON(str string) (out Semana, err error) {
+	año, semana, err := parsearSemana(str)
+	if err != nil {
+		return out, err
+	}
+	return NewSemana(año, semana)
+}
+
+// NewFechaFromSemanaISO parsea una fecha de semana ISO con el formato
+// "2020-W34-7", donde el último número es el día de la semana (1 lunes, 7 domingo).
+func NewFechaFromSemanaISO(str string) (Fecha, error) {
+	if len(str) != 10 || str[8] != '-' || str[9] < '1' || str[9] > '7' {
+		return 0, fmt.Errorf("incorrect format: expected YYYY-Www-D; got: %v", str)
+	}
+	s, err := NewSemanaFromJSON(str[:8])
+	if err != nil {
+		return 0, err
+	}
+	return s.PrimerDia().AgregarDias(int(str[9] - '1')), nil
+}
+
+// SemanasDelAño devuelve la cantidad de semanas ISO del año (52 o 53).
+func SemanasDelAño(año int) int {
+	// El 28 de diciembre siempre está en la última semana del año
+	return fechaDesdeCivil(año, 12, 28).Semana().semana
+}
+
+// Semana devuelve la semana ISO que contiene a la fecha.
+// Infinito y MenosInfinito devuelven NilSemana.
+// Se supone que se está trabajando con una fecha válida.
+func (f Fecha) Semana() Semana {
+	if f.IsInfinite() {
+		return NilSemana
+	}
+	dias := f.dias()
+	jueves := dias - diaISO(dias) + 3
+	año, _, _ := civilDesdeDias(jueves)
+	return Semana{año, (jueves-diasDesdeCivil(año, 1, 1))/7 + 1}
+}
+
+// Año devuelve el año de la semana, que puede no coincidir con el de sus días.
+func (s Semana) Año() int {
+	return s.año
+}
+
+// SemanaDelAño devuelve el número de semana (1 a 53).
+func (s Semana) SemanaDelAño() int {
+	return s.semana
+}
+
+// Valid devuelve true si la semana es válida.
+func (s Semana) Valid() bool {
+	return validarAño(s.año) == nil && s.semana >= 1 && s.semana <= SemanasDelAño(s.año)
+}
+
+// Zero devuelve true si la semana y el año son cero.
+func (s Semana) Zero() bool {
+	return s == Semana{}
+}
+
+func (s Semana) Anterior(s2 Semana) bool {
+	return s.lunes() < s2.lunes()
+}
+
+func (s Semana) AnteriorOIgual(s2 Semana) bool {
+	return s.lunes() <= s2.lunes()
+}
+
+func (s Semana) Posterior(s2 Semana) bool {
+	return s.lunes() > s2.lunes()
+}
+
+func (s Semana) PosteriorOIgual(s2 Semana) bool {
+	return s.lunes() >= s2.lunes()
+}
+
+// SumarSemanas devuelve una nueva semana con las semanas agregadas.
+// Si se quiere restar, ingresar semanas en negativo.
+// Se supone que se está trabajando con una Semana válida no cero.
+func (s Semana) SumarSemanas(semanas int) Semana {
+	return fechaDesdeDias(s.lunes() + semanas*7).Semana()
+}
+
+// SemanasEntre devuelve la cantidad de semanas desde s1 hasta s2.
+// Si s2 es anterior a s1, devuelve la cantidad en negativo.
+func SemanasEntre(s1, s2 Semana) int {
+	return (s2.lunes() - s1.lunes()) / 7
+}
+
+// Dia devuelve la fecha del día de la semana ingresado.
+func (s Semana) Dia(dia time.Weekday) Fecha {
+	return fechaDesdeDias(s.lunes() + modPiso(int(dia)-1, 7))
+}
+
+// PrimerDia devuelve el lunes de la semana.
+func (s Semana) PrimerDia() Fecha {
+	return fechaDesdeDias(s.lunes())
+}
+
+// UltimoDia devuelve el domingo de la semana.
+func (s Semana) UltimoDia() Fecha {
+	return fechaDesdeDias(s.lunes() + 6)
+}
+
+// Dias devuelve las fechas de lunes a domingo.
+func (s Semana) Dias() (out [7]Fecha) {
+	lunes := s.lunes()
+	for i := range out {
+		out[i] = fechaDesdeDias(lunes + i)
+	}
+	return out
+}
+
+// Rango devuelve el rango de lunes a domingo.
+func (s Semana) Rango() Rango {
+	return Rango{Desde: s.PrimerDia(), Hasta: s.UltimoDia()}
+}
+
+// String devuelve la semana con el formato "2020-W34".
+func (s Semana) String() string {
+	if !s.Valid() {
+		return "semana inválida"
+	}
+	return string(s.appendISO(nil))
+}
+
+// JSONString devuelve la representación que se utiliza en JSON ("2020-W34").
+// Si no es válida devuelve null.
+func (s Semana) JSONString() string {
+	if !s.Valid() {
+		return "null"
+	}
+	return string(s.appendISO(nil))
+}
+
+// MarshalJSON es para tomar una struct a un string JSON.
+func (s Semana) MarshalJSON() ([]byte, error) {
+	if s.Zero() {
+		return []byte("null"), nil
+	}
+	if !s.Valid() {
+		return nil, fmt.Errorf("cannot marshal invalid week '%v-W%v'", s.año, s.semana)
+	}
+	by := s.appendISO([]byte{'"'})
+	return append(by, '"'), nil
+}
+
+// UnmarshalJSON es para parsear el string a una struct Semana.
+// Si llega una cadena null o vacía, se crea una struct con valor cero.
+func (s *Semana) UnmarshalJSON(input []byte) (err error) {
+	texto, nulo := textoJSON(input)
+	if nulo {
+		*s = Semana{}
+		return nil
+	}
+	*s, err = NewSemanaFromJSON(texto)
+	return
+}
+
+func (s Semana) appendISO(by []byte) []byte {
+	by = appendCuatroDigitos(by, s.año)
+	by = append(by, '-', 'W')
+	return appendDosDigitos(by, s.semana)
+}
+
+// Devuelve el número de día (desde 01/01/1970) del lunes de la semana.
+func (s Semana) lunes() int {
+	enero4 := diasDesdeCivil(s.año, 1, 4)
+	return enero4 - diaISO(enero4) + (s.semana-1)*7
+}
+
+// Devuelve el día de la semana contando el lunes como 0 y el domingo como 6.
+func diaISO(dias int) int {
+	return modPiso(int(diaDeLaSemanaDesdeDias(dias))-1, 7)
+}
+
+func parsearSemana(str string) (año, semana int, err error) {
+	if len(str) != 8 || str[4] != '-' || str[5] != 'W' {
+		return 0, 0, fmt.Errorf("incorrect format: expected YYYY-Www; got: %v", str)
+	}
+	// strconv.Atoi acepta signos ("2020-W+4"), así que se exigen dígitos.
+	for _, i := range []int{0, 1, 2, 3, 6, 7} {
+		if !esDigito(str[i]) {
+			return 0, 0, fmt.Errorf("incorrect format: expected YYYY-Www; got: %v", str)
+		}
+	}
+	año, err = strconv.Atoi(str[:4])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid year '%v': %w", str, err)
+	}
+	semana, err = strconv.Atoi(str[6:])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid week '%v': %w", str, err)
+	}
+	return año, semana, nil
+}
@@ -0,0 +1,139 @@
+package fecha
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFechaSemana(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(Semana{2020, 34}, Fecha(20200817).Semana())
+	assert.Equal(Semana{2020, 34}, Fecha(20200823).Semana())
+	assert.Equal(Semana{2020, 35}, Fecha(20200824).Semana())
+
+	// Cambio de año
+	assert.Equal(Semana{2020, 53}, Fecha(20210101).Semana())
+	assert.Equal(Semana{2020, 53}, Fecha(20210103).Semana())
+	assert.Equal(Semana{2021, 1}, Fecha(20210104).Semana())
+	assert.Equal(Semana{2025, 1}, Fecha(20241230).Semana())
+	assert.Equal(Semana{2025, 1}, Fecha(20241231).Semana())
+
+	// Contra el package time
+	f := Fecha(20151220)
+	for i := 0; i < 3000; i++ {
+		año, semana := f.Time().ISOWeek()
+		assert.Equal(Semana{año, semana}, f.Semana(), f.String())
+		f = f.AgregarDias(1)
+	}
+}
+
+func TestNewSemana(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(53, SemanasDelAño(2020))
+	assert.Equal(52, SemanasDelAño(2021))
+	assert.Equal(53, SemanasDelAño(2026))
+
+	s, err := NewSemana(2020, 53)
+	assert.Nil(err)
+	assert.True(s.Valid())
+
+	_, err = NewSemana(2021, 53)
+	assert.NotNil(err)
+	_, err = NewSemana(2021, 0)
+	assert.NotNil(err)
+	_, err = NewSemana(AñoMinimo-1, 1)
+	assert.NotNil(err)
+	assert.Panics(func() { NewSemanaMust(2021, 53) })
+
+	assert.True(Semana{}.Zero())
+	assert.False(Semana{}.Valid())
+}
+
+func TestDiasSemana(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewSemanaMust(2020, 53)
+	assert.Equal(Fecha(20201228), s.PrimerDia())
+	assert.Equal(Fecha(20210103), s.UltimoDia())
+	assert.Equal(Fecha(20210101), s.Dia(time.Friday))
+	assert.Equal(Fecha(20210103), s.Dia(time.Sunday))
+	assert.Equal(Rango{20201228, 20210103}, s.Rango())
+	assert.Equal([7]Fecha{20201228, 20201229, 20201230, 20201231, 20210101, 20210102, 20210103}, s.Dias())
+
+	assert.Equal(Fecha(20191230), NewSemanaMust(2020, 1).PrimerDia())
+}
+
+func TestSumarSemanas(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewSemanaMust(2020, 52)
+	assert.Equal(Semana{2020, 53}, s.SumarSemanas(1))
+	assert.Equal(Semana{2021, 1}, s.SumarSemanas(2))
+	assert.Equal(Semana{2019, 52}, NewSemanaMust(2020, 1).SumarSemanas(-1))
+	assert.Equal(Semana{2020, 52}, s.SumarSemanas(0))
+
+	assert.Equal(2, SemanasEntre(s, Semana{2021, 1}))
+	assert.Equal(-2, SemanasEntre(Semana{2021, 1}, s))
+
+	assert.True(s.Anterior(Semana{2021, 1}))
+	assert.True(s.AnteriorOIgual(s))
+	assert.True(Semana{2021, 1}.Posterior(s))
+	assert.True(s.PosteriorOIgual(s))
+	assert.False(s.Posterior(s))
+}
+
+func TestParsearSemana(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := NewSemanaFromJSON("2020-W34")
+	assert.Nil(err)
+	assert.Equal(Semana{2020, 34}, s)
+
+	for _, v := range []string{"2020-34", "2020W34", "2020-W5", "2021-W53", "2020-Wxx", "2020-W+4", "2020-W-4", "+020-W34"} {
+		_, err := NewSemanaFromJSON(v)
+		assert.NotNil(err, v)
+	}
+
+	f, err := NewFechaFromSemanaISO("2020-W34-7")
+	assert.Nil(err)
+	assert.Equal(Fecha(20200823), f)
+
+	f, err = NewFechaFromSemanaISO("2020-W53-5")
+	assert.Nil(err)
+	assert.Equal(Fecha(20210101), f)
+
+	for _, v := range []string{"2020-W34-0", "2020-W34-8", "2020-W34", "2020-W54-1"} {
+		_, err := NewFechaFromSemanaISO(v)
+		assert.NotNil(err, v)
+	}
+}
+
+func TestJSONSemana(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("2020-W05", Semana{2020, 5}.String())
+	assert.Equal("semana inválida", Semana{2021, 53}.String())
+
+	by, err := json.Marshal(Semana{2020, 34})
+	assert.Nil(err)
+	assert.Equal(`"2020-W34"`, string(by))
+
+	var s Semana
+	assert.Nil(json.Unmarshal(by, &s))
+	assert.Equal(Semana{2020, 34}, s)
+
+	by, err = json.Marshal(Semana{})
+	assert.Nil(err)
+	assert.Equal(`null`, string(by))
+
+	assert.Nil(json.Unmarshal([]byte(`null`), &s))
+	assert.True(s.Zero())
+
+	_, err = json.Marshal(Semana{2021, 53})
+	assert.NotNil(err)
+}